@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"errors"
+	"net"
+
+	"go.uber.org/fx"
+)
+
+// ErrNoAddress indicates that an AddressProvider found no suitable address.
+var ErrNoAddress = errors.New("no suitable advertise address was found")
+
+// AddressConfig describes how an AddressProvider selects the address this
+// application advertises to consul.
+type AddressConfig struct {
+	// Address is an explicit address. If set, no detection is performed.
+	Address string `json:"address" yaml:"address" mapstructure:"address"`
+
+	// Interface restricts detection to the network interface with this name, e.g. eth0.
+	// If unset, all interfaces are considered.
+	Interface string `json:"interface" yaml:"interface" mapstructure:"interface"`
+
+	// CIDR restricts detection to addresses within this network, e.g. 10.0.0.0/8.
+	// This is useful on multi-homed hosts.
+	CIDR string `json:"cidr" yaml:"cidr" mapstructure:"cidr"`
+}
+
+// AddressProvider determines the address this application advertises to consul.
+// This is typically needed in containers and on multi-homed hosts, where the
+// address that other services must use is not obvious.
+//
+// Unless an explicit address is configured, candidates come from the configured
+// interface or from every interface. Link-local addresses are never selected,
+// and loopback addresses are only selected from an explicitly named interface.
+// When more than one candidate remains, IPv4 addresses are preferred.
+type AddressProvider struct {
+	cfg     AddressConfig
+	network *net.IPNet
+
+	// interfaceAddrs returns the addresses of the named interface, or of
+	// all interfaces if name is empty.
+	interfaceAddrs func(name string) ([]net.Addr, error)
+}
+
+// NewAddressProvider creates an AddressProvider from the given configuration.
+// An invalid CIDR results in an error.
+func NewAddressProvider(cfg AddressConfig) (*AddressProvider, error) {
+	ap := &AddressProvider{
+		cfg:            cfg,
+		interfaceAddrs: interfaceAddrs,
+	}
+
+	if len(cfg.CIDR) > 0 {
+		var err error
+		if _, ap.network, err = net.ParseCIDR(cfg.CIDR); err != nil {
+			return nil, err
+		}
+	}
+
+	return ap, nil
+}
+
+// interfaceAddrs is the default, system-backed strategy for obtaining interface addresses.
+func interfaceAddrs(name string) ([]net.Addr, error) {
+	if len(name) == 0 {
+		return net.InterfaceAddrs()
+	}
+
+	iface, err := net.InterfaceByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return iface.Addrs()
+}
+
+// Address returns the advertise address. Interfaces are examined on each call,
+// so the result reflects the host's current network configuration.
+func (ap *AddressProvider) Address() (string, error) {
+	if len(ap.cfg.Address) > 0 {
+		return ap.cfg.Address, nil
+	}
+
+	addrs, err := ap.interfaceAddrs(ap.cfg.Interface)
+	if err != nil {
+		return "", err
+	}
+
+	var selected net.IP
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok {
+			continue
+		}
+
+		ip := ipNet.IP
+		switch {
+		case ip.IsLinkLocalUnicast():
+			continue
+
+		case ip.IsLoopback() && len(ap.cfg.Interface) == 0:
+			continue
+
+		case ap.network != nil && !ap.network.Contains(ip):
+			continue
+
+		case ip.To4() != nil:
+			return ip.String(), nil
+
+		case selected == nil:
+			selected = ip
+		}
+	}
+
+	if selected == nil {
+		return "", ErrNoAddress
+	}
+
+	return selected.String(), nil
+}
+
+// ProvideAddressProvider emits an *AddressProvider for the given configuration.
+func ProvideAddressProvider(cfg AddressConfig) fx.Option {
+	return fx.Provide(
+		func() (*AddressProvider, error) {
+			return NewAddressProvider(cfg)
+		},
+	)
+}
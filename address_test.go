@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+type AddressProviderSuite struct {
+	suite.Suite
+}
+
+// newAddressProvider creates an AddressProvider whose interfaces are simulated.
+func (suite *AddressProviderSuite) newAddressProvider(cfg AddressConfig, interfaces map[string][]string) *AddressProvider {
+	ap, err := NewAddressProvider(cfg)
+	suite.Require().NoError(err)
+
+	ap.interfaceAddrs = func(name string) (addrs []net.Addr, err error) {
+		for iface, cidrs := range interfaces {
+			if len(name) > 0 && iface != name {
+				continue
+			}
+
+			for _, cidr := range cidrs {
+				ip, network, err := net.ParseCIDR(cidr)
+				suite.Require().NoError(err)
+				network.IP = ip
+				addrs = append(addrs, network)
+			}
+		}
+
+		if len(name) > 0 && len(addrs) == 0 {
+			err = errors.New("no such interface")
+		}
+
+		return
+	}
+
+	return ap
+}
+
+func (suite *AddressProviderSuite) TestAddress() {
+	interfaces := map[string][]string{
+		"lo":   {"127.0.0.1/8", "::1/128"},
+		"eth0": {"fe80::1/64", "fd00::10/64", "10.1.2.3/16"},
+		"eth1": {"2001:db8::5/64"},
+	}
+
+	testCases := []struct {
+		name     string
+		cfg      AddressConfig
+		expected string
+	}{
+		{
+			name:     "Explicit",
+			cfg:      AddressConfig{Address: "192.168.1.1", Interface: "eth0"},
+			expected: "192.168.1.1",
+		},
+		{
+			name:     "FirstNonLoopback",
+			cfg:      AddressConfig{},
+			expected: "10.1.2.3",
+		},
+		{
+			name:     "Interface",
+			cfg:      AddressConfig{Interface: "eth1"},
+			expected: "2001:db8::5",
+		},
+		{
+			name:     "LoopbackInterface",
+			cfg:      AddressConfig{Interface: "lo"},
+			expected: "127.0.0.1",
+		},
+		{
+			name:     "CIDR",
+			cfg:      AddressConfig{CIDR: "fd00::/8"},
+			expected: "fd00::10",
+		},
+		{
+			name:     "InterfaceAndCIDR",
+			cfg:      AddressConfig{Interface: "eth0", CIDR: "10.0.0.0/8"},
+			expected: "10.1.2.3",
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(testCase.name, func() {
+			address, err := suite.newAddressProvider(testCase.cfg, interfaces).Address()
+			suite.Require().NoError(err)
+			suite.Equal(testCase.expected, address)
+		})
+	}
+}
+
+func (suite *AddressProviderSuite) TestNoAddress() {
+	ap := suite.newAddressProvider(
+		AddressConfig{CIDR: "192.168.0.0/16"},
+		map[string][]string{
+			"eth0": {"10.1.2.3/16"},
+		},
+	)
+
+	_, err := ap.Address()
+	suite.ErrorIs(err, ErrNoAddress)
+}
+
+func (suite *AddressProviderSuite) TestInterfaceError() {
+	ap := suite.newAddressProvider(AddressConfig{Interface: "missing"}, nil)
+	_, err := ap.Address()
+	suite.Error(err)
+}
+
+func (suite *AddressProviderSuite) TestInvalidCIDR() {
+	ap, err := NewAddressProvider(AddressConfig{CIDR: "not a cidr"})
+	suite.Error(err)
+	suite.Nil(ap)
+}
+
+func (suite *AddressProviderSuite) TestSystemInterfaces() {
+	ap, err := NewAddressProvider(AddressConfig{Interface: "lo"})
+	suite.Require().NoError(err)
+
+	// the loopback interface's name is platform-specific, so only check consistency
+	if address, err := ap.Address(); err == nil {
+		suite.True(net.ParseIP(address).IsLoopback())
+	}
+}
+
+func (suite *AddressProviderSuite) TestProvideAddressProvider() {
+	var ap *AddressProvider
+	app := fxtest.New(
+		suite.T(),
+		ProvideAddressProvider(AddressConfig{Address: "10.0.0.1"}),
+		fx.Populate(&ap),
+	)
+
+	suite.Require().NotNil(ap)
+	app.RequireStart()
+
+	address, err := ap.Address()
+	suite.NoError(err)
+	suite.Equal("10.0.0.1", address)
+	app.RequireStop()
+}
+
+func TestAddressProvider(t *testing.T) {
+	suite.Run(t, new(AddressProviderSuite))
+}
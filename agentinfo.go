@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/consul/api"
+	"go.uber.org/fx"
+)
+
+// ErrAgentInfo indicates that the local agent's self-description was missing
+// a required field.
+var ErrAgentInfo = errors.New("consul agent did not report its configuration")
+
+// AgentInfo describes the consul agent that a client is talking to.
+type AgentInfo struct {
+	// NodeName is the name of the agent's node.
+	NodeName string
+
+	// Datacenter is the agent's datacenter.
+	Datacenter string
+
+	// Version is the agent's consul version.
+	Version string
+}
+
+// NewAgentInfo queries the agent once for its self-description. NodeName and
+// Datacenter are required. Version is optional, since consul withholds it when
+// the agent's configuration is not visible to the client's token.
+func NewAgentInfo(agent *api.Agent) (ai AgentInfo, err error) {
+	var self map[string]map[string]interface{}
+	self, err = agent.Self()
+	if err != nil {
+		return
+	}
+
+	config := self["Config"]
+	ai.NodeName, _ = config["NodeName"].(string)
+	ai.Datacenter, _ = config["Datacenter"].(string)
+	ai.Version, _ = config["Version"].(string)
+
+	if len(ai.NodeName) == 0 || len(ai.Datacenter) == 0 {
+		err = fmt.Errorf("%w: node name and datacenter are required", ErrAgentInfo)
+	}
+
+	return
+}
+
+// AgentInfoOut is the set of components emitted by ProvideAgentInfo. The individual
+// fields are named so that other modules, e.g. logging or metrics, can consume them
+// as plain strings.
+type AgentInfoOut struct {
+	fx.Out
+
+	Info       AgentInfo
+	NodeName   string `name:"consul.nodeName"`
+	Datacenter string `name:"consul.datacenter"`
+	Version    string `name:"consul.version"`
+}
+
+// newAgentInfoOut adapts NewAgentInfo for dependency injection.
+func newAgentInfoOut(agent *api.Agent) (out AgentInfoOut, err error) {
+	out.Info, err = NewAgentInfo(agent)
+	out.NodeName = out.Info.NodeName
+	out.Datacenter = out.Info.Datacenter
+	out.Version = out.Info.Version
+	return
+}
+
+// ProvideAgentInfo queries the local agent when the components are first needed.
+// The agent is queried only once, and the application fails to start if that
+// query fails. The following components are emitted:
+//
+//   - AgentInfo
+//   - string `name:"consul.nodeName"`
+//   - string `name:"consul.datacenter"`
+//   - string `name:"consul.version"`
+//
+// This provider requires an *api.Agent, such as the one emitted by Provide.
+func ProvideAgentInfo() fx.Option {
+	return fx.Provide(newAgentInfoOut)
+}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/praetor/praetortest"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+type AgentInfoSuite struct {
+	suite.Suite
+}
+
+func (suite *AgentInfoSuite) TestNewAgentInfo() {
+	consul := praetortest.NewServer(suite.T())
+	ai, err := NewAgentInfo(consul.Client(suite.T()).Agent())
+	suite.Require().NoError(err)
+	suite.Equal(
+		AgentInfo{
+			NodeName:   praetortest.NodeName,
+			Datacenter: praetortest.Datacenter,
+			Version:    praetortest.Version,
+		},
+		ai,
+	)
+}
+
+func (suite *AgentInfoSuite) TestMissingConfig() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"Config": {"Version": "1.20.0"}}`))
+	}))
+
+	defer server.Close()
+	client, err := api.NewClient(&api.Config{Address: server.Listener.Addr().String()})
+	suite.Require().NoError(err)
+
+	_, err = NewAgentInfo(client.Agent())
+	suite.ErrorIs(err, ErrAgentInfo)
+}
+
+func (suite *AgentInfoSuite) TestUnavailable() {
+	consul := praetortest.NewServer(suite.T())
+	agent := consul.Client(suite.T()).Agent()
+	consul.Close()
+
+	_, err := NewAgentInfo(agent)
+	suite.Error(err)
+}
+
+func (suite *AgentInfoSuite) TestProvideAgentInfo() {
+	consul := praetortest.NewServer(suite.T())
+
+	var in struct {
+		fx.In
+
+		Info       AgentInfo
+		NodeName   string `name:"consul.nodeName"`
+		Datacenter string `name:"consul.datacenter"`
+		Version    string `name:"consul.version"`
+	}
+
+	app := fxtest.New(
+		suite.T(),
+		fx.Supply(consul.Config()),
+		Provide(),
+		ProvideAgentInfo(),
+		fx.Populate(&in),
+	)
+
+	app.RequireStart()
+	defer app.RequireStop()
+	suite.Equal(praetortest.NodeName, in.Info.NodeName)
+	suite.Equal(praetortest.NodeName, in.NodeName)
+	suite.Equal(praetortest.Datacenter, in.Datacenter)
+	suite.Equal(praetortest.Version, in.Version)
+}
+
+func TestAgentInfo(t *testing.T) {
+	suite.Run(t, new(AgentInfoSuite))
+}
@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/consul/api"
+)
+
+// MaxTxnOps is the largest number of operations consul accepts in a single transaction.
+const MaxTxnOps = 64
+
+var (
+	// ErrEmptyTxn indicates that a CatalogTxn had no operations.
+	ErrEmptyTxn = errors.New("a catalog transaction must have at least one operation")
+
+	// ErrTxnTooLarge indicates that a CatalogTxn had more than MaxTxnOps operations.
+	ErrTxnTooLarge = errors.New("a catalog transaction has too many operations")
+)
+
+// TxnRolledBackError is returned when consul rejects a transaction. None of the
+// transaction's operations were applied.
+type TxnRolledBackError struct {
+	// Errors holds consul's explanation of each failed operation.
+	Errors api.TxnErrors
+}
+
+// Error describes each failed operation.
+func (err *TxnRolledBackError) Error() string {
+	var o strings.Builder
+	o.WriteString("consul rolled back the transaction")
+	for i, e := range err.Errors {
+		if i == 0 {
+			o.WriteString(": ")
+		} else {
+			o.WriteString("; ")
+		}
+
+		fmt.Fprintf(&o, "operation %d: %s", e.OpIndex, e.What)
+	}
+
+	return o.String()
+}
+
+// CatalogTxn builds a consul transaction that registers or deregisters catalog
+// nodes, services, and checks atomically. This is typically used to manage external
+// services, i.e. services that do not run alongside a consul agent, where a node and
+// all of its services and checks must appear or disappear together.
+//
+// Each method validates its operation. Validation errors are accumulated and
+// reported, joined, by Ops or Execute.
+type CatalogTxn struct {
+	ops  api.TxnOps
+	ids  map[string]bool
+	errs []error
+}
+
+// NewCatalogTxn creates an empty CatalogTxn.
+func NewCatalogTxn() *CatalogTxn {
+	return &CatalogTxn{
+		ids: make(map[string]bool),
+	}
+}
+
+// add appends an operation, rejecting a second operation on the same entity.
+func (t *CatalogTxn) add(id string, op *api.TxnOp) *CatalogTxn {
+	if t.ids[id] {
+		t.errs = append(t.errs, fmt.Errorf("duplicate operation on %s", id))
+	} else {
+		t.ids[id] = true
+		t.ops = append(t.ops, op)
+	}
+
+	return t
+}
+
+// SetNode registers or updates a node. The node's name and address are required.
+func (t *CatalogTxn) SetNode(node api.Node) *CatalogTxn {
+	if len(node.Node) == 0 || len(node.Address) == 0 {
+		t.errs = append(t.errs, errors.New("a catalog node requires a name and an address"))
+		return t
+	}
+
+	return t.add(
+		"node "+node.Node,
+		&api.TxnOp{
+			Node: &api.NodeTxnOp{Verb: api.NodeSet, Node: node},
+		},
+	)
+}
+
+// DeleteNode deregisters a node along with all of its services and checks.
+// The node's name is required.
+func (t *CatalogTxn) DeleteNode(node string) *CatalogTxn {
+	if len(node) == 0 {
+		t.errs = append(t.errs, errors.New("deleting a catalog node requires a name"))
+		return t
+	}
+
+	return t.add(
+		"node "+node,
+		&api.TxnOp{
+			Node: &api.NodeTxnOp{Verb: api.NodeDelete, Node: api.Node{Node: node}},
+		},
+	)
+}
+
+// SetService registers or updates a service on a node. The service's name is
+// required. If the service has no ID, its name is used as the ID.
+func (t *CatalogTxn) SetService(node string, service api.AgentService) *CatalogTxn {
+	if len(service.ID) == 0 {
+		service.ID = service.Service
+	}
+
+	if len(node) == 0 || len(service.Service) == 0 {
+		t.errs = append(t.errs, errors.New("a catalog service requires a node and a service name"))
+		return t
+	}
+
+	return t.add(
+		"service "+node+"/"+service.ID,
+		&api.TxnOp{
+			Service: &api.ServiceTxnOp{Verb: api.ServiceSet, Node: node, Service: service},
+		},
+	)
+}
+
+// DeleteService deregisters a service from a node. The node and service ID are required.
+func (t *CatalogTxn) DeleteService(node, serviceID string) *CatalogTxn {
+	if len(node) == 0 || len(serviceID) == 0 {
+		t.errs = append(t.errs, errors.New("deleting a catalog service requires a node and a service ID"))
+		return t
+	}
+
+	return t.add(
+		"service "+node+"/"+serviceID,
+		&api.TxnOp{
+			Service: &api.ServiceTxnOp{Verb: api.ServiceDelete, Node: node, Service: api.AgentService{ID: serviceID}},
+		},
+	)
+}
+
+// SetCheck registers or updates a check. The check's node and ID are required.
+// If the check has no status, it is registered as critical.
+func (t *CatalogTxn) SetCheck(check api.HealthCheck) *CatalogTxn {
+	if len(check.Status) == 0 {
+		check.Status = api.HealthCritical
+	}
+
+	switch {
+	case len(check.Node) == 0 || len(check.CheckID) == 0:
+		t.errs = append(t.errs, errors.New("a catalog check requires a node and a check ID"))
+		return t
+
+	case check.Status != api.HealthPassing && check.Status != api.HealthWarning && check.Status != api.HealthCritical:
+		t.errs = append(t.errs, fmt.Errorf("check %q: %w: %q", check.CheckID, ErrInvalidCheckStatus, check.Status))
+		return t
+	}
+
+	return t.add(
+		"check "+check.Node+"/"+check.CheckID,
+		&api.TxnOp{
+			Check: &api.CheckTxnOp{Verb: api.CheckSet, Check: check},
+		},
+	)
+}
+
+// DeleteCheck deregisters a check from a node. The node and check ID are required.
+func (t *CatalogTxn) DeleteCheck(node, checkID string) *CatalogTxn {
+	if len(node) == 0 || len(checkID) == 0 {
+		t.errs = append(t.errs, errors.New("deleting a catalog check requires a node and a check ID"))
+		return t
+	}
+
+	return t.add(
+		"check "+node+"/"+checkID,
+		&api.TxnOp{
+			Check: &api.CheckTxnOp{Verb: api.CheckDelete, Check: api.HealthCheck{Node: node, CheckID: checkID}},
+		},
+	)
+}
+
+// Ops returns the transaction's operations, in the order they were added. If any
+// operation was invalid, the validation errors are returned instead. A transaction
+// with more than MaxTxnOps operations is rejected with ErrTxnTooLarge, since consul
+// would reject it.
+func (t *CatalogTxn) Ops() (api.TxnOps, error) {
+	switch {
+	case len(t.errs) > 0:
+		return nil, errors.Join(t.errs...)
+
+	case len(t.ops) == 0:
+		return nil, ErrEmptyTxn
+
+	case len(t.ops) > MaxTxnOps:
+		return nil, fmt.Errorf("%w: %d operations exceeds the limit of %d", ErrTxnTooLarge, len(t.ops), MaxTxnOps)
+
+	default:
+		return append(api.TxnOps(nil), t.ops...), nil
+	}
+}
+
+// Execute submits this transaction to consul. If consul rejects the transaction,
+// a *TxnRolledBackError is returned.
+func (t *CatalogTxn) Execute(ctx context.Context, txn *api.Txn) error {
+	ops, err := t.Ops()
+	if err != nil {
+		return err
+	}
+
+	ok, resp, _, err := txn.Txn(ops, new(api.QueryOptions).WithContext(ctx))
+	switch {
+	case err != nil:
+		return err
+
+	case !ok:
+		return &TxnRolledBackError{Errors: resp.Errors}
+
+	default:
+		return nil
+	}
+}
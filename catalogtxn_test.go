@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/praetor/praetortest"
+)
+
+type CatalogTxnSuite struct {
+	suite.Suite
+
+	consul *praetortest.Server
+	txn    *api.Txn
+}
+
+func (suite *CatalogTxnSuite) SetupTest() {
+	suite.consul = praetortest.NewServer(suite.T())
+	suite.txn = suite.consul.Client(suite.T()).Txn()
+}
+
+func (suite *CatalogTxnSuite) TestRegisterDeregister() {
+	suite.Require().NoError(
+		NewCatalogTxn().
+			SetNode(api.Node{Node: "external", Address: "db.example.com"}).
+			SetService("external", api.AgentService{Service: "db", Port: 5432}).
+			SetCheck(api.HealthCheck{Node: "external", CheckID: "db", ServiceID: "db"}).
+			Execute(context.Background(), suite.txn),
+	)
+
+	service, ok := suite.consul.CatalogService("external", "db")
+	suite.True(ok)
+	suite.Equal("db", service.ID)
+	suite.Equal(5432, service.Port)
+
+	check, ok := suite.consul.CatalogCheck("external", "db")
+	suite.True(ok)
+	suite.Equal(api.HealthCritical, check.Status)
+
+	suite.Require().NoError(
+		NewCatalogTxn().
+			DeleteCheck("external", "db").
+			DeleteService("external", "db").
+			Execute(context.Background(), suite.txn),
+	)
+
+	_, ok = suite.consul.CatalogService("external", "db")
+	suite.False(ok)
+
+	_, ok = suite.consul.CatalogCheck("external", "db")
+	suite.False(ok)
+
+	suite.Require().NoError(
+		NewCatalogTxn().
+			DeleteNode("external").
+			Execute(context.Background(), suite.txn),
+	)
+
+	_, ok = suite.consul.CatalogNode("external")
+	suite.False(ok)
+}
+
+func (suite *CatalogTxnSuite) TestRolledBack() {
+	err := NewCatalogTxn().
+		SetNode(api.Node{Node: "external", Address: "db.example.com"}).
+		SetService("missing", api.AgentService{Service: "db"}).
+		Execute(context.Background(), suite.txn)
+
+	var rolledBack *TxnRolledBackError
+	suite.Require().ErrorAs(err, &rolledBack)
+	suite.Require().Len(rolledBack.Errors, 1)
+	suite.Equal(1, rolledBack.Errors[0].OpIndex)
+	suite.Contains(err.Error(), "operation 1:")
+
+	_, ok := suite.consul.CatalogNode("external")
+	suite.False(ok)
+}
+
+func (suite *CatalogTxnSuite) TestInvalid() {
+	testCases := []struct {
+		name string
+		txn  *CatalogTxn
+	}{
+		{
+			name: "Empty",
+			txn:  NewCatalogTxn(),
+		},
+		{
+			name: "NodeWithoutAddress",
+			txn:  NewCatalogTxn().SetNode(api.Node{Node: "external"}),
+		},
+		{
+			name: "ServiceWithoutName",
+			txn:  NewCatalogTxn().SetService("external", api.AgentService{ID: "db"}),
+		},
+		{
+			name: "CheckWithoutID",
+			txn:  NewCatalogTxn().SetCheck(api.HealthCheck{Node: "external"}),
+		},
+		{
+			name: "BadCheckStatus",
+			txn:  NewCatalogTxn().SetCheck(api.HealthCheck{Node: "external", CheckID: "db", Status: "unknown"}),
+		},
+		{
+			name: "DeleteNodeWithoutName",
+			txn:  NewCatalogTxn().DeleteNode(""),
+		},
+		{
+			name: "DeleteServiceWithoutID",
+			txn:  NewCatalogTxn().DeleteService("external", ""),
+		},
+		{
+			name: "DeleteCheckWithoutNode",
+			txn:  NewCatalogTxn().DeleteCheck("", "db"),
+		},
+		{
+			name: "DuplicateService",
+			txn: NewCatalogTxn().
+				SetService("external", api.AgentService{Service: "db"}).
+				DeleteService("external", "db"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(testCase.name, func() {
+			ops, err := testCase.txn.Ops()
+			suite.Error(err)
+			suite.Nil(ops)
+
+			suite.Error(testCase.txn.Execute(context.Background(), suite.txn))
+		})
+	}
+}
+
+func (suite *CatalogTxnSuite) TestOps() {
+	ops, err := NewCatalogTxn().
+		SetNode(api.Node{Node: "external", Address: "db.example.com"}).
+		DeleteService("external", "old").
+		Ops()
+
+	suite.Require().NoError(err)
+	suite.Require().Len(ops, 2)
+	suite.Equal(api.NodeSet, ops[0].Node.Verb)
+	suite.Equal(api.ServiceDelete, ops[1].Service.Verb)
+	suite.Equal("old", ops[1].Service.Service.ID)
+}
+
+func (suite *CatalogTxnSuite) TestTooLarge() {
+	txn := NewCatalogTxn()
+	for i := 0; i < MaxTxnOps; i++ {
+		txn.DeleteCheck("external", fmt.Sprintf("check-%d", i))
+	}
+
+	ops, err := txn.Ops()
+	suite.Require().NoError(err)
+	suite.Len(ops, MaxTxnOps)
+
+	ops, err = txn.DeleteNode("external").Ops()
+	suite.ErrorIs(err, ErrTxnTooLarge)
+	suite.Nil(ops)
+}
+
+func (suite *CatalogTxnSuite) TestConsulUnavailable() {
+	suite.consul.Close()
+	err := NewCatalogTxn().
+		DeleteNode("external").
+		Execute(context.Background(), suite.txn)
+
+	suite.Error(err)
+	suite.NotErrorAs(err, new(*TxnRolledBackError))
+}
+
+func TestCatalogTxn(t *testing.T) {
+	suite.Run(t, new(CatalogTxnSuite))
+}
@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+)
+
+// DefaultHTTPCheckInterval is the interval used by a CheckBuilder when none is set.
+const DefaultHTTPCheckInterval = 10 * time.Second
+
+var (
+	// ErrCheckAddressRequired indicates that a CheckBuilder was given no address.
+	ErrCheckAddressRequired = errors.New("an address is required for an HTTP check")
+
+	// ErrInvalidCheckScheme indicates that a CheckBuilder was given a scheme
+	// other than http or https.
+	ErrInvalidCheckScheme = errors.New("an HTTP check scheme must be http or https")
+
+	// ErrNegativeCheckDuration indicates that a CheckBuilder or CheckConfig was given
+	// a negative duration.
+	ErrNegativeCheckDuration = errors.New("check durations cannot be negative")
+)
+
+// CheckBuilder constructs a consul HTTP check from an application's own HTTP
+// server settings. This lets the check be derived from the same configuration
+// that binds the server, rather than duplicating addresses and ports in consul
+// configuration.
+//
+// The zero value is not usable. Create a CheckBuilder with NewCheckBuilder.
+type CheckBuilder struct {
+	id, name        string
+	scheme          string
+	host            string
+	address         string
+	path            string
+	method          string
+	header          http.Header
+	interval        time.Duration
+	timeout         time.Duration
+	deregisterAfter time.Duration
+	tlsServerName   string
+	tlsSkipVerify   bool
+}
+
+// NewCheckBuilder starts building an HTTP check with the given check ID and name.
+// Either may be empty, in which case consul assigns defaults.
+func NewCheckBuilder(id, name string) *CheckBuilder {
+	return &CheckBuilder{
+		id:     id,
+		name:   name,
+		scheme: "http",
+		path:   "/",
+		header: make(http.Header),
+	}
+}
+
+// Server takes the scheme and address from an *http.Server. A server with a
+// TLSConfig is checked using https.
+func (cb *CheckBuilder) Server(s *http.Server) *CheckBuilder {
+	if s.TLSConfig != nil {
+		cb.scheme = "https"
+	}
+
+	return cb.Address(s.Addr)
+}
+
+// Listener takes the address from a bound net.Listener. This is useful when the
+// server is bound to port 0, since the listener has the actual port.
+func (cb *CheckBuilder) Listener(l net.Listener) *CheckBuilder {
+	return cb.Address(l.Addr().String())
+}
+
+// Address sets the host:port of the server. If the host is empty or an unspecified
+// address such as 0.0.0.0, the check uses the loopback address unless Host is also set.
+func (cb *CheckBuilder) Address(address string) *CheckBuilder {
+	cb.address = address
+	return cb
+}
+
+// Host overrides the host taken from the server address, e.g. when the consul
+// agent must reach this application through a different interface.
+func (cb *CheckBuilder) Host(host string) *CheckBuilder {
+	cb.host = host
+	return cb
+}
+
+// Scheme sets the URI scheme, which must be http or https. The default is http.
+func (cb *CheckBuilder) Scheme(scheme string) *CheckBuilder {
+	cb.scheme = scheme
+	return cb
+}
+
+// Path sets the URI path of the health endpoint. The default is "/".
+func (cb *CheckBuilder) Path(path string) *CheckBuilder {
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	cb.path = path
+	return cb
+}
+
+// Method sets the HTTP method consul uses. If unset, consul uses GET.
+func (cb *CheckBuilder) Method(method string) *CheckBuilder {
+	cb.method = method
+	return cb
+}
+
+// Header adds an HTTP header that consul sends with each check.
+func (cb *CheckBuilder) Header(name, value string) *CheckBuilder {
+	cb.header.Add(name, value)
+	return cb
+}
+
+// Interval sets how often consul runs the check. If unset, DefaultHTTPCheckInterval is used.
+func (cb *CheckBuilder) Interval(d time.Duration) *CheckBuilder {
+	cb.interval = d
+	return cb
+}
+
+// Timeout sets the timeout for each check request. If unset, consul's default is used.
+func (cb *CheckBuilder) Timeout(d time.Duration) *CheckBuilder {
+	cb.timeout = d
+	return cb
+}
+
+// DeregisterCriticalServiceAfter sets how long the check may be critical before
+// consul deregisters the service. If unset, the service is never deregistered.
+func (cb *CheckBuilder) DeregisterCriticalServiceAfter(d time.Duration) *CheckBuilder {
+	cb.deregisterAfter = d
+	return cb
+}
+
+// TLSServerName sets the server name consul uses to verify an https check.
+func (cb *CheckBuilder) TLSServerName(serverName string) *CheckBuilder {
+	cb.tlsServerName = serverName
+	return cb
+}
+
+// TLSSkipVerify disables certificate verification for an https check.
+func (cb *CheckBuilder) TLSSkipVerify(skip bool) *CheckBuilder {
+	cb.tlsSkipVerify = skip
+	return cb
+}
+
+// url validates the scheme and address and produces the check URL.
+func (cb *CheckBuilder) url() (string, error) {
+	if cb.scheme != "http" && cb.scheme != "https" {
+		return "", fmt.Errorf("%w: %q", ErrInvalidCheckScheme, cb.scheme)
+	}
+
+	if len(cb.address) == 0 {
+		return "", ErrCheckAddressRequired
+	}
+
+	host, port, err := net.SplitHostPort(cb.address)
+	switch {
+	case err != nil:
+		return "", fmt.Errorf("invalid HTTP check address %q: %w", cb.address, err)
+
+	case len(port) == 0 || port == "0":
+		return "", fmt.Errorf("HTTP check address %q has no port", cb.address)
+
+	case len(cb.host) > 0:
+		host = cb.host
+
+	case len(host) == 0 || net.ParseIP(host).IsUnspecified():
+		host = "127.0.0.1"
+	}
+
+	u := url.URL{
+		Scheme: cb.scheme,
+		Host:   net.JoinHostPort(host, port),
+		Path:   cb.path,
+	}
+
+	return u.String(), nil
+}
+
+// Build validates this builder's settings and produces the consul check.
+func (cb *CheckBuilder) Build() (*api.AgentServiceCheck, error) {
+	checkURL, err := cb.url()
+	switch {
+	case err != nil:
+		return nil, err
+
+	case cb.interval < 0 || cb.timeout < 0 || cb.deregisterAfter < 0:
+		return nil, ErrNegativeCheckDuration
+	}
+
+	check := &api.AgentServiceCheck{
+		CheckID:       cb.id,
+		Name:          cb.name,
+		HTTP:          checkURL,
+		Method:        cb.method,
+		Interval:      DefaultHTTPCheckInterval.String(),
+		TLSServerName: cb.tlsServerName,
+		TLSSkipVerify: cb.tlsSkipVerify,
+	}
+
+	if len(cb.header) > 0 {
+		check.Header = cb.header.Clone()
+	}
+
+	if cb.interval > 0 {
+		check.Interval = cb.interval.String()
+	}
+
+	if cb.timeout > 0 {
+		check.Timeout = cb.timeout.String()
+	}
+
+	if cb.deregisterAfter > 0 {
+		check.DeregisterCriticalServiceAfter = cb.deregisterAfter.String()
+	}
+
+	return check, nil
+}
@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+)
+
+type CheckBuilderSuite struct {
+	suite.Suite
+}
+
+func (suite *CheckBuilderSuite) build(cb *CheckBuilder) *api.AgentServiceCheck {
+	check, err := cb.Build()
+	suite.Require().NoError(err)
+	suite.Require().NotNil(check)
+	return check
+}
+
+func (suite *CheckBuilderSuite) TestDefaults() {
+	check := suite.build(
+		NewCheckBuilder("", "").Address("example.com:8080"),
+	)
+
+	suite.Equal(
+		api.AgentServiceCheck{
+			HTTP:     "http://example.com:8080/",
+			Interval: DefaultHTTPCheckInterval.String(),
+		},
+		*check,
+	)
+}
+
+func (suite *CheckBuilderSuite) TestFull() {
+	check := suite.build(
+		NewCheckBuilder("web:http", "web health").
+			Scheme("https").
+			Address(":8443").
+			Host("web.internal").
+			Path("health").
+			Method(http.MethodHead).
+			Header("X-Check", "consul").
+			Interval(5 * time.Second).
+			Timeout(time.Second).
+			DeregisterCriticalServiceAfter(time.Minute).
+			TLSServerName("web.example.com").
+			TLSSkipVerify(true),
+	)
+
+	suite.Equal(
+		api.AgentServiceCheck{
+			CheckID:                        "web:http",
+			Name:                           "web health",
+			HTTP:                           "https://web.internal:8443/health",
+			Method:                         http.MethodHead,
+			Header:                         map[string][]string{"X-Check": {"consul"}},
+			Interval:                       "5s",
+			Timeout:                        "1s",
+			DeregisterCriticalServiceAfter: "1m0s",
+			TLSServerName:                  "web.example.com",
+			TLSSkipVerify:                  true,
+		},
+		*check,
+	)
+}
+
+func (suite *CheckBuilderSuite) TestUnspecifiedHost() {
+	for _, address := range []string{":8080", "0.0.0.0:8080", "[::]:8080"} {
+		suite.Run(address, func() {
+			check := suite.build(NewCheckBuilder("", "").Address(address))
+			suite.Equal("http://127.0.0.1:8080/", check.HTTP)
+		})
+	}
+}
+
+func (suite *CheckBuilderSuite) TestServer() {
+	suite.Run("HTTP", func() {
+		check := suite.build(
+			NewCheckBuilder("", "").Server(&http.Server{Addr: ":8080"}),
+		)
+
+		suite.Equal("http://127.0.0.1:8080/", check.HTTP)
+	})
+
+	suite.Run("HTTPS", func() {
+		check := suite.build(
+			NewCheckBuilder("", "").Server(&http.Server{
+				Addr:      "10.0.0.1:8443",
+				TLSConfig: &tls.Config{},
+			}),
+		)
+
+		suite.Equal("https://10.0.0.1:8443/", check.HTTP)
+	})
+}
+
+func (suite *CheckBuilderSuite) TestListener() {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	suite.Require().NoError(err)
+	defer l.Close()
+
+	check := suite.build(NewCheckBuilder("", "").Listener(l).Path("/health"))
+	suite.Equal("http://"+l.Addr().String()+"/health", check.HTTP)
+}
+
+func (suite *CheckBuilderSuite) TestInvalid() {
+	testCases := []struct {
+		name        string
+		builder     *CheckBuilder
+		expectedErr error
+	}{
+		{
+			name:        "NoAddress",
+			builder:     NewCheckBuilder("", ""),
+			expectedErr: ErrCheckAddressRequired,
+		},
+		{
+			name:        "BadScheme",
+			builder:     NewCheckBuilder("", "").Scheme("ftp").Address(":8080"),
+			expectedErr: ErrInvalidCheckScheme,
+		},
+		{
+			name:    "BadAddress",
+			builder: NewCheckBuilder("", "").Address("localhost"),
+		},
+		{
+			name:    "NoPort",
+			builder: NewCheckBuilder("", "").Address("localhost:0"),
+		},
+		{
+			name:        "NegativeInterval",
+			builder:     NewCheckBuilder("", "").Address(":8080").Interval(-time.Second),
+			expectedErr: ErrNegativeCheckDuration,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(testCase.name, func() {
+			check, err := testCase.builder.Build()
+			suite.Error(err)
+			suite.Nil(check)
+			if testCase.expectedErr != nil {
+				suite.ErrorIs(err, testCase.expectedErr)
+			}
+		})
+	}
+}
+
+func TestCheckBuilder(t *testing.T) {
+	suite.Run(t, new(CheckBuilderSuite))
+}
@@ -0,0 +1,246 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+)
+
+var (
+	// ErrCheckType indicates that a CheckConfig did not specify exactly one
+	// of TTL, HTTP, TCP, or GRPC.
+	ErrCheckType = errors.New("exactly one of ttl, http, tcp, or grpc must be set for a check")
+
+	// ErrCheckIntervalRequired indicates that an HTTP, TCP, or GRPC check had no interval.
+	ErrCheckIntervalRequired = errors.New("an interval is required for http, tcp, and grpc checks")
+
+	// ErrInvalidCheckStatus indicates that a CheckConfig had an unrecognized initial status.
+	ErrInvalidCheckStatus = errors.New("a check status must be passing, warning, or critical")
+)
+
+// CheckConfig is an easily unmarshalable configuration for a consul service check.
+// Fields in this struct mirror those of api.AgentServiceCheck, except that durations
+// are time.Duration values rather than strings.
+//
+// Durations may be written as strings such as "30s" in both YAML and JSON. JSON
+// also accepts integer nanoseconds. When decoding with mapstructure, string durations
+// require mapstructure.StringToTimeDurationHookFunc, which viper installs by default.
+type CheckConfig struct {
+	// CheckID is the unique ID of the check. If unset, consul generates one.
+	CheckID string `json:"checkID" yaml:"checkID" mapstructure:"checkID"`
+
+	// Name is the human-readable name of the check.
+	Name string `json:"name" yaml:"name" mapstructure:"name"`
+
+	// Notes is optional, human-readable text describing the check.
+	Notes string `json:"notes" yaml:"notes" mapstructure:"notes"`
+
+	// Status is the initial status of the check. If unset, consul's default of critical is used.
+	Status string `json:"status" yaml:"status" mapstructure:"status"`
+
+	// TTL makes this a TTL check, which the application must update more often than this interval.
+	TTL time.Duration `json:"ttl" yaml:"ttl" mapstructure:"ttl"`
+
+	// HTTP makes this an HTTP check against the given URL.
+	HTTP string `json:"http" yaml:"http" mapstructure:"http"`
+
+	// Method is the HTTP method used by an HTTP check. If unset, consul uses GET.
+	Method string `json:"method" yaml:"method" mapstructure:"method"`
+
+	// Header holds the HTTP headers sent by an HTTP check.
+	Header map[string][]string `json:"header" yaml:"header" mapstructure:"header"`
+
+	// TCP makes this a TCP check against the given host:port.
+	TCP string `json:"tcp" yaml:"tcp" mapstructure:"tcp"`
+
+	// GRPC makes this a gRPC health check against the given host:port, optionally
+	// followed by a slash and the service name.
+	GRPC string `json:"grpc" yaml:"grpc" mapstructure:"grpc"`
+
+	// GRPCUseTLS indicates whether a gRPC check uses TLS.
+	GRPCUseTLS bool `json:"grpcUseTLS" yaml:"grpcUseTLS" mapstructure:"grpcUseTLS"`
+
+	// Interval is how often consul runs an HTTP, TCP, or gRPC check. This field is
+	// required for those checks.
+	Interval time.Duration `json:"interval" yaml:"interval" mapstructure:"interval"`
+
+	// Timeout is the timeout for each run of an HTTP, TCP, or gRPC check. If unset,
+	// consul's default is used.
+	Timeout time.Duration `json:"timeout" yaml:"timeout" mapstructure:"timeout"`
+
+	// TLSServerName is the server name used to verify an HTTPS or gRPC TLS check.
+	TLSServerName string `json:"tlsServerName" yaml:"tlsServerName" mapstructure:"tlsServerName"`
+
+	// TLSSkipVerify disables certificate verification for an HTTPS or gRPC TLS check.
+	TLSSkipVerify bool `json:"tlsSkipVerify" yaml:"tlsSkipVerify" mapstructure:"tlsSkipVerify"`
+
+	// DeregisterCriticalServiceAfter is how long the check may be critical before consul
+	// deregisters the service. If unset, the service is never deregistered.
+	DeregisterCriticalServiceAfter time.Duration `json:"deregisterCriticalServiceAfter" yaml:"deregisterCriticalServiceAfter" mapstructure:"deregisterCriticalServiceAfter"`
+
+	// SuccessBeforePassing is the number of consecutive successes required before
+	// the check becomes passing.
+	SuccessBeforePassing int `json:"successBeforePassing" yaml:"successBeforePassing" mapstructure:"successBeforePassing"`
+
+	// FailuresBeforeCritical is the number of consecutive failures required before
+	// the check becomes critical.
+	FailuresBeforeCritical int `json:"failuresBeforeCritical" yaml:"failuresBeforeCritical" mapstructure:"failuresBeforeCritical"`
+}
+
+// UnmarshalJSON decodes a CheckConfig, accepting either duration strings or integer
+// nanoseconds for the duration fields. encoding/json alone only accepts the latter.
+func (src *CheckConfig) UnmarshalJSON(data []byte) error {
+	// plain has no methods, which prevents infinite recursion
+	type plain CheckConfig
+	aux := struct {
+		*plain
+		TTL                            jsonDuration `json:"ttl"`
+		Interval                       jsonDuration `json:"interval"`
+		Timeout                        jsonDuration `json:"timeout"`
+		DeregisterCriticalServiceAfter jsonDuration `json:"deregisterCriticalServiceAfter"`
+	}{
+		plain:                          (*plain)(src),
+		TTL:                            jsonDuration(src.TTL),
+		Interval:                       jsonDuration(src.Interval),
+		Timeout:                        jsonDuration(src.Timeout),
+		DeregisterCriticalServiceAfter: jsonDuration(src.DeregisterCriticalServiceAfter),
+	}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	src.TTL = time.Duration(aux.TTL)
+	src.Interval = time.Duration(aux.Interval)
+	src.Timeout = time.Duration(aux.Timeout)
+	src.DeregisterCriticalServiceAfter = time.Duration(aux.DeregisterCriticalServiceAfter)
+	return nil
+}
+
+// jsonDuration is a time.Duration that decodes from either a JSON string in the
+// format accepted by time.ParseDuration or a JSON number of nanoseconds.
+type jsonDuration time.Duration
+
+// UnmarshalJSON decodes a duration string or number. A JSON null leaves d unchanged.
+func (d *jsonDuration) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		v, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+
+		*d = jsonDuration(v)
+		return nil
+	}
+
+	var n int64
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("invalid duration %s: %w", data, err)
+	}
+
+	*d = jsonDuration(n)
+	return nil
+}
+
+// formatDuration converts a duration into consul's string form. Zero durations
+// become the empty string, so that consul applies its defaults.
+func formatDuration(d time.Duration) string {
+	if d > 0 {
+		return d.String()
+	}
+
+	return ""
+}
+
+// validate checks that src describes exactly one kind of check with sensible settings.
+func (src CheckConfig) validate() error {
+	kinds := 0
+	for _, set := range []bool{src.TTL > 0, len(src.HTTP) > 0, len(src.TCP) > 0, len(src.GRPC) > 0} {
+		if set {
+			kinds++
+		}
+	}
+
+	switch {
+	case src.TTL < 0 || src.Interval < 0 || src.Timeout < 0 || src.DeregisterCriticalServiceAfter < 0:
+		return ErrNegativeCheckDuration
+
+	case kinds != 1:
+		return ErrCheckType
+
+	case src.TTL <= 0 && src.Interval <= 0:
+		return ErrCheckIntervalRequired
+	}
+
+	switch src.Status {
+	case "", api.HealthPassing, api.HealthWarning, api.HealthCritical:
+		return nil
+
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidCheckStatus, src.Status)
+	}
+}
+
+// NewAgentServiceCheck validates a praetor CheckConfig and converts it into
+// a consul api.AgentServiceCheck.
+func NewAgentServiceCheck(src CheckConfig) (*api.AgentServiceCheck, error) {
+	if err := src.validate(); err != nil {
+		if len(src.CheckID) > 0 {
+			err = fmt.Errorf("check %q: %w", src.CheckID, err)
+		}
+
+		return nil, err
+	}
+
+	return &api.AgentServiceCheck{
+		CheckID:                        src.CheckID,
+		Name:                           src.Name,
+		Notes:                          src.Notes,
+		Status:                         src.Status,
+		TTL:                            formatDuration(src.TTL),
+		HTTP:                           src.HTTP,
+		Method:                         src.Method,
+		Header:                         src.Header,
+		TCP:                            src.TCP,
+		GRPC:                           src.GRPC,
+		GRPCUseTLS:                     src.GRPCUseTLS,
+		Interval:                       formatDuration(src.Interval),
+		Timeout:                        formatDuration(src.Timeout),
+		TLSServerName:                  src.TLSServerName,
+		TLSSkipVerify:                  src.TLSSkipVerify,
+		DeregisterCriticalServiceAfter: formatDuration(src.DeregisterCriticalServiceAfter),
+		SuccessBeforePassing:           src.SuccessBeforePassing,
+		FailuresBeforeCritical:         src.FailuresBeforeCritical,
+	}, nil
+}
+
+// NewAgentServiceChecks converts a slice of praetor CheckConfigs into consul
+// api.AgentServiceChecks. The first invalid configuration halts the conversion.
+func NewAgentServiceChecks(src []CheckConfig) (api.AgentServiceChecks, error) {
+	if len(src) == 0 {
+		return nil, nil
+	}
+
+	dst := make(api.AgentServiceChecks, 0, len(src))
+	for _, c := range src {
+		check, err := NewAgentServiceCheck(c)
+		if err != nil {
+			return nil, err
+		}
+
+		dst = append(dst, check)
+	}
+
+	return dst, nil
+}
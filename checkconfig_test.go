@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+)
+
+type CheckConfigSuite struct {
+	suite.Suite
+}
+
+func (suite *CheckConfigSuite) TestTTL() {
+	check, err := NewAgentServiceCheck(CheckConfig{
+		CheckID: "web:ttl",
+		Name:    "web",
+		Status:  api.HealthPassing,
+		TTL:     30 * time.Second,
+	})
+
+	suite.Require().NoError(err)
+	suite.Equal(
+		api.AgentServiceCheck{
+			CheckID: "web:ttl",
+			Name:    "web",
+			Status:  api.HealthPassing,
+			TTL:     "30s",
+		},
+		*check,
+	)
+}
+
+func (suite *CheckConfigSuite) TestHTTP() {
+	check, err := NewAgentServiceCheck(CheckConfig{
+		HTTP:                           "https://localhost:8443/health",
+		Method:                         "HEAD",
+		Header:                         map[string][]string{"X-Check": {"consul"}},
+		Interval:                       10 * time.Second,
+		Timeout:                        2 * time.Second,
+		TLSServerName:                  "web.example.com",
+		TLSSkipVerify:                  true,
+		DeregisterCriticalServiceAfter: time.Hour,
+		SuccessBeforePassing:           2,
+		FailuresBeforeCritical:         3,
+	})
+
+	suite.Require().NoError(err)
+	suite.Equal(
+		api.AgentServiceCheck{
+			HTTP:                           "https://localhost:8443/health",
+			Method:                         "HEAD",
+			Header:                         map[string][]string{"X-Check": {"consul"}},
+			Interval:                       "10s",
+			Timeout:                        "2s",
+			TLSServerName:                  "web.example.com",
+			TLSSkipVerify:                  true,
+			DeregisterCriticalServiceAfter: "1h0m0s",
+			SuccessBeforePassing:           2,
+			FailuresBeforeCritical:         3,
+		},
+		*check,
+	)
+}
+
+func (suite *CheckConfigSuite) TestInvalid() {
+	testCases := []struct {
+		name        string
+		src         CheckConfig
+		expectedErr error
+	}{
+		{
+			name:        "NoType",
+			src:         CheckConfig{Interval: time.Second},
+			expectedErr: ErrCheckType,
+		},
+		{
+			name:        "TwoTypes",
+			src:         CheckConfig{TCP: "localhost:80", GRPC: "localhost:81", Interval: time.Second},
+			expectedErr: ErrCheckType,
+		},
+		{
+			name:        "NoInterval",
+			src:         CheckConfig{TCP: "localhost:80"},
+			expectedErr: ErrCheckIntervalRequired,
+		},
+		{
+			name:        "NegativeTimeout",
+			src:         CheckConfig{TCP: "localhost:80", Interval: time.Second, Timeout: -time.Second},
+			expectedErr: ErrNegativeCheckDuration,
+		},
+		{
+			name:        "BadStatus",
+			src:         CheckConfig{CheckID: "bad", TTL: time.Second, Status: "unknown"},
+			expectedErr: ErrInvalidCheckStatus,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(testCase.name, func() {
+			check, err := NewAgentServiceCheck(testCase.src)
+			suite.ErrorIs(err, testCase.expectedErr)
+			suite.Nil(check)
+		})
+	}
+}
+
+func (suite *CheckConfigSuite) TestNewAgentServiceChecks() {
+	suite.Run("Empty", func() {
+		checks, err := NewAgentServiceChecks(nil)
+		suite.NoError(err)
+		suite.Nil(checks)
+	})
+
+	suite.Run("Valid", func() {
+		checks, err := NewAgentServiceChecks([]CheckConfig{
+			{TTL: time.Minute},
+			{TCP: "localhost:80", Interval: time.Second},
+		})
+
+		suite.Require().NoError(err)
+		suite.Require().Len(checks, 2)
+		suite.Equal("1m0s", checks[0].TTL)
+		suite.Equal("localhost:80", checks[1].TCP)
+	})
+
+	suite.Run("Invalid", func() {
+		checks, err := NewAgentServiceChecks([]CheckConfig{
+			{TTL: time.Minute},
+			{TCP: "localhost:80"},
+		})
+
+		suite.ErrorIs(err, ErrCheckIntervalRequired)
+		suite.Nil(checks)
+	})
+}
+
+func (suite *CheckConfigSuite) TestUnmarshalYAML() {
+	var src CheckConfig
+	suite.Require().NoError(
+		yaml.Unmarshal(
+			[]byte("checkID: web\nhttp: http://localhost/\ninterval: 15s\ntimeout: 3s\n"),
+			&src,
+		),
+	)
+
+	check, err := NewAgentServiceCheck(src)
+	suite.Require().NoError(err)
+	suite.Equal("15s", check.Interval)
+	suite.Equal("3s", check.Timeout)
+}
+
+func (suite *CheckConfigSuite) TestUnmarshalJSON() {
+	suite.Run("Strings", func() {
+		var src CheckConfig
+		suite.Require().NoError(
+			json.Unmarshal(
+				[]byte(`{"checkID": "web", "http": "http://localhost/", "interval": "15s", "timeout": "3s", "deregisterCriticalServiceAfter": "1m"}`),
+				&src,
+			),
+		)
+
+		suite.Equal("web", src.CheckID)
+		suite.Equal("http://localhost/", src.HTTP)
+		suite.Equal(15*time.Second, src.Interval)
+		suite.Equal(3*time.Second, src.Timeout)
+		suite.Equal(time.Minute, src.DeregisterCriticalServiceAfter)
+
+		check, err := NewAgentServiceCheck(src)
+		suite.Require().NoError(err)
+		suite.Equal("15s", check.Interval)
+		suite.Equal("3s", check.Timeout)
+	})
+
+	suite.Run("Nanoseconds", func() {
+		var src CheckConfig
+		suite.Require().NoError(
+			json.Unmarshal([]byte(`{"ttl": 30000000000, "timeout": null}`), &src),
+		)
+
+		suite.Equal(30*time.Second, src.TTL)
+		suite.Zero(src.Timeout)
+	})
+
+	suite.Run("Invalid", func() {
+		var src CheckConfig
+		suite.Error(json.Unmarshal([]byte(`{"ttl": "thirty seconds"}`), &src))
+		suite.Error(json.Unmarshal([]byte(`{"ttl": true}`), &src))
+	})
+}
+
+func TestCheckConfig(t *testing.T) {
+	suite.Run(t, new(CheckConfigSuite))
+}
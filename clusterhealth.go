@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"go.uber.org/fx"
+)
+
+// DefaultClusterHealthInterval is the time between cluster health checks when
+// no Interval is configured.
+const DefaultClusterHealthInterval = 10 * time.Second
+
+// ClusterHealthConfig configures a ClusterHealthMonitor.
+type ClusterHealthConfig struct {
+	// Interval is the time between cluster health checks. If unset,
+	// DefaultClusterHealthInterval is used.
+	Interval time.Duration `json:"interval" yaml:"interval" mapstructure:"interval"`
+
+	// Options are the query options used for each check, e.g. for datacenter
+	// or token. The context is managed by the monitor.
+	Options api.QueryOptions `json:"-" yaml:"-" mapstructure:"-"`
+}
+
+// ClusterHealthEvent describes the health of the consul servers.
+type ClusterHealthEvent struct {
+	// Healthy is true if autopilot reports all servers as healthy and the
+	// cluster has a leader.
+	Healthy bool
+
+	// Leader is the raft address of the current leader. This will be empty if
+	// the cluster has no leader.
+	Leader string
+
+	// FailureTolerance is the number of servers that could be lost without an
+	// outage, as reported by autopilot.
+	FailureTolerance int
+
+	// Err is any error that occurred while querying consul. When this is set,
+	// Healthy is false and the other fields are not meaningful.
+	Err error
+}
+
+// ClusterHealthListener is a sink for ClusterHealthEvents.
+type ClusterHealthListener func(ClusterHealthEvent)
+
+// ClusterHealthMonitor periodically checks the health of the consul cluster using
+// autopilot and the current raft leader. An unhealthy cluster may serve stale
+// discovery data, so applications can use this monitor to gate work or to report
+// degraded health of their own. Listeners are notified whenever the cluster's
+// health changes.
+type ClusterHealthMonitor struct {
+	operator *api.Operator
+	status   *api.Status
+	cfg      ClusterHealthConfig
+
+	listeners listeners[ClusterHealthListener]
+
+	lock sync.Mutex
+	last ClusterHealthEvent
+
+	poller poller[ClusterHealthEvent]
+}
+
+// NewClusterHealthMonitor creates a ClusterHealthMonitor. The returned monitor
+// must be started before it will check the cluster.
+func NewClusterHealthMonitor(client *api.Client, cfg ClusterHealthConfig) *ClusterHealthMonitor {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultClusterHealthInterval
+	}
+
+	return &ClusterHealthMonitor{
+		operator: client.Operator(),
+		status:   client.Status(),
+		cfg:      cfg,
+		poller:   poller[ClusterHealthEvent]{interval: cfg.Interval},
+	}
+}
+
+// AddListener registers a listener for subsequent events. The returned
+// function removes the listener.
+func (m *ClusterHealthMonitor) AddListener(l ClusterHealthListener) (cancel func()) {
+	return m.listeners.add(l)
+}
+
+// ClusterHealthy returns whether the most recent check found the cluster healthy.
+// This method returns false before the monitor is started.
+func (m *ClusterHealthMonitor) ClusterHealthy() bool {
+	return m.Last().Healthy
+}
+
+// Last returns the most recent event dispatched by this monitor.
+func (m *ClusterHealthMonitor) Last() ClusterHealthEvent {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.last
+}
+
+// Start performs an initial check and dispatches its result, then begins checking
+// periodically in a background goroutine. A failed initial check is dispatched as
+// an event and does not prevent the monitor from starting, since reporting an
+// unavailable cluster is the point of this component.
+func (m *ClusterHealthMonitor) Start(ctx context.Context) error {
+	return m.poller.start(ctx, m.check, m.initial, m.next)
+}
+
+// Stop halts the background goroutine started by Start, waiting until it exits
+// or the given context is canceled. This method is idempotent.
+func (m *ClusterHealthMonitor) Stop(ctx context.Context) error {
+	return m.poller.stop(ctx)
+}
+
+// check queries autopilot and the raft leader.
+func (m *ClusterHealthMonitor) check(ctx context.Context) (e ClusterHealthEvent) {
+	q := m.cfg.Options
+	q.WaitIndex = 0
+
+	var reply *api.OperatorHealthReply
+	reply, e.Err = m.operator.AutopilotServerHealth(q.WithContext(ctx))
+	if e.Err != nil {
+		return
+	}
+
+	e.Leader, e.Err = m.status.LeaderWithQueryOptions(q.WithContext(ctx))
+	if e.Err != nil {
+		e.Leader = ""
+		return
+	}
+
+	e.FailureTolerance = reply.FailureTolerance
+	e.Healthy = reply.Healthy && len(e.Leader) > 0
+	return
+}
+
+// dispatch records an event and sends it to all listeners.
+func (m *ClusterHealthMonitor) dispatch(e ClusterHealthEvent) {
+	m.lock.Lock()
+	m.last = e
+	m.lock.Unlock()
+
+	m.listeners.visit(func(l ClusterHealthListener) {
+		l(e)
+	})
+}
+
+// changed tests whether two events describe different cluster health.
+func (e ClusterHealthEvent) changed(other ClusterHealthEvent) bool {
+	if (e.Err == nil) != (other.Err == nil) {
+		return true
+	}
+
+	if e.Err != nil {
+		return e.Err.Error() != other.Err.Error()
+	}
+
+	return e.Healthy != other.Healthy ||
+		e.Leader != other.Leader ||
+		e.FailureTolerance != other.FailureTolerance
+}
+
+// initial handles the result of the check made by Start.
+func (m *ClusterHealthMonitor) initial(e ClusterHealthEvent) {
+	m.dispatch(e)
+}
+
+// next handles the result of each subsequent check, dispatching it only if the
+// cluster's health changed.
+func (m *ClusterHealthMonitor) next(e ClusterHealthEvent) {
+	if e.changed(m.Last()) {
+		m.dispatch(e)
+	}
+}
+
+// ProvideClusterHealthMonitor emits a *ClusterHealthMonitor bound to the enclosing
+// application's lifecycle. The monitor's initial check happens when the application
+// starts, and the monitor is stopped when the application stops.
+//
+// This provider requires an *api.Client, such as the one emitted by Provide.
+func ProvideClusterHealthMonitor(cfg ClusterHealthConfig) fx.Option {
+	return fx.Provide(
+		func(client *api.Client, l fx.Lifecycle) *ClusterHealthMonitor {
+			m := NewClusterHealthMonitor(client, cfg)
+			l.Append(fx.StartStopHook(m.Start, m.Stop))
+			return m
+		},
+	)
+}
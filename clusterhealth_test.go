@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/praetor/praetortest"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+type ClusterHealthSuite struct {
+	suite.Suite
+
+	consul *praetortest.Server
+	client *api.Client
+}
+
+func (suite *ClusterHealthSuite) SetupTest() {
+	suite.consul = praetortest.NewServer(suite.T())
+	suite.client = suite.consul.Client(suite.T())
+}
+
+func (suite *ClusterHealthSuite) newMonitor() (*ClusterHealthMonitor, <-chan ClusterHealthEvent) {
+	m := NewClusterHealthMonitor(suite.client, ClusterHealthConfig{
+		Interval: 10 * time.Millisecond,
+	})
+
+	events := make(chan ClusterHealthEvent, 10)
+	m.AddListener(func(e ClusterHealthEvent) {
+		events <- e
+	})
+
+	return m, events
+}
+
+func (suite *ClusterHealthSuite) nextEvent(events <-chan ClusterHealthEvent) ClusterHealthEvent {
+	select {
+	case e := <-events:
+		return e
+
+	case <-time.After(5 * time.Second):
+		suite.FailNow("no cluster health event")
+		return ClusterHealthEvent{}
+	}
+}
+
+func (suite *ClusterHealthSuite) TestDefaults() {
+	m := NewClusterHealthMonitor(suite.client, ClusterHealthConfig{})
+	suite.Equal(DefaultClusterHealthInterval, m.cfg.Interval)
+	suite.False(m.ClusterHealthy())
+	suite.NoError(m.Stop(context.Background()))
+}
+
+func (suite *ClusterHealthSuite) TestMonitor() {
+	m, events := suite.newMonitor()
+	suite.Require().NoError(m.Start(context.Background()))
+	defer m.Stop(context.Background())
+	suite.ErrorIs(m.Start(context.Background()), ErrAlreadyStarted)
+
+	e := suite.nextEvent(events)
+	suite.True(e.Healthy)
+	suite.Equal(praetortest.LeaderAddress, e.Leader)
+	suite.NoError(e.Err)
+	suite.True(m.ClusterHealthy())
+
+	suite.consul.SetClusterHealthy(false)
+	e = suite.nextEvent(events)
+	suite.False(e.Healthy)
+	suite.NoError(e.Err)
+	suite.False(m.ClusterHealthy())
+
+	suite.consul.SetClusterHealthy(true)
+	e = suite.nextEvent(events)
+	suite.True(e.Healthy)
+
+	suite.consul.SetLeader("")
+	e = suite.nextEvent(events)
+	suite.False(e.Healthy)
+	suite.Empty(e.Leader)
+	suite.Equal(e, m.Last())
+
+	suite.NoError(m.Stop(context.Background()))
+	suite.NoError(m.Stop(context.Background()))
+}
+
+func (suite *ClusterHealthSuite) TestUnavailable() {
+	m, events := suite.newMonitor()
+	suite.consul.Close()
+	suite.Require().NoError(m.Start(context.Background()))
+	defer m.Stop(context.Background())
+
+	e := suite.nextEvent(events)
+	suite.Error(e.Err)
+	suite.False(e.Healthy)
+	suite.False(m.ClusterHealthy())
+
+	select {
+	case e := <-events:
+		suite.Failf("duplicate event", "%v", e)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func (suite *ClusterHealthSuite) TestProvideClusterHealthMonitor() {
+	var m *ClusterHealthMonitor
+	app := fxtest.New(
+		suite.T(),
+		fx.Supply(suite.client),
+		ProvideClusterHealthMonitor(ClusterHealthConfig{}),
+		fx.Populate(&m),
+	)
+
+	suite.Require().NotNil(m)
+	suite.False(m.ClusterHealthy())
+
+	app.RequireStart()
+	suite.True(m.ClusterHealthy())
+	app.RequireStop()
+}
+
+func TestClusterHealth(t *testing.T) {
+	suite.Run(t, new(ClusterHealthSuite))
+}
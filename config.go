@@ -4,9 +4,19 @@
 package praetor
 
 import (
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/consul/api"
+	"github.com/hashicorp/go-cleanhttp"
 )
 
 // BasicAuthConfig holds the HTTP basic authorization credentials for Consul.
@@ -16,6 +26,30 @@ type BasicAuthConfig struct {
 
 	// Password is the HTTP basic auth user name.
 	Password string `json:"password" yaml:"password" mapstructure:"password"`
+
+	// PasswordFile is a file containing the HTTP basic auth password. The file is read
+	// once, when the consul api.Config is created. Surrounding whitespace is ignored.
+	// If this is set, Password must not be set.
+	PasswordFile string `json:"passwordFile" yaml:"passwordFile" mapstructure:"passwordFile"`
+}
+
+// ErrPasswordAndPasswordFile indicates that a BasicAuthConfig specified both a password
+// and a password file.
+var ErrPasswordAndPasswordFile = errors.New("a basic auth password and password file cannot both be configured")
+
+// password returns the configured password, reading PasswordFile if necessary.
+func (ba BasicAuthConfig) password() (string, error) {
+	switch {
+	case len(ba.PasswordFile) == 0:
+		return ba.Password, nil
+
+	case len(ba.Password) > 0:
+		return "", ErrPasswordAndPasswordFile
+
+	default:
+		data, err := os.ReadFile(ba.PasswordFile)
+		return strings.TrimSpace(string(data)), err
+	}
 }
 
 // TLSConfig holds the TLS options supported by praetor.
@@ -40,8 +74,190 @@ type TLSConfig struct {
 	// If this is set, CertificateFile must also be set.
 	KeyFile string `json:"keyFile" yaml:"keyFile" mapstructure:"keyFile"`
 
+	// CAPem is a CA certificate bundle used for Consul communication, supplied in memory
+	// rather than as a file. This may be PEM text or base64-encoded PEM.
+	CAPem string `json:"caPem" yaml:"caPem" mapstructure:"caPem"`
+
+	// CertificatePem is the certificate used in Consul communication, supplied in memory
+	// rather than as a file. This may be PEM text or base64-encoded PEM.
+	// If this is set, KeyPem must also be set.
+	CertificatePem string `json:"certificatePem" yaml:"certificatePem" mapstructure:"certificatePem"`
+
+	// KeyPem is the key used in Consul communication, supplied in memory rather than
+	// as a file. This may be PEM text or base64-encoded PEM.
+	// If this is set, CertificatePem must also be set.
+	KeyPem string `json:"keyPem" yaml:"keyPem" mapstructure:"keyPem"`
+
 	// InsecureSkipVerify controls whether TLS host verification is disabled.
 	InsecureSkipVerify bool `json:"insecureSkipVerify" yaml:"insecureSkipVerify" mapstructure:"insecureSkipVerify"`
+
+	// MinVersion is the minimum TLS version used for Consul communication, either "1.2"
+	// or "1.3", optionally prefixed with "TLS". TLS 1.0 and 1.1 are not allowed. If unset,
+	// the crypto/tls default is used.
+	MinVersion string `json:"minVersion" yaml:"minVersion" mapstructure:"minVersion"`
+
+	// CipherSuites restricts the cipher suites used for Consul communication, using the
+	// crypto/tls names such as "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256". Insecure suites
+	// are not allowed. As with crypto/tls, this has no effect on TLS 1.3 connections.
+	CipherSuites []string `json:"cipherSuites" yaml:"cipherSuites" mapstructure:"cipherSuites"`
+}
+
+// isZero tests if no field of this TLSConfig is set.
+func (t TLSConfig) isZero() bool {
+	return len(t.Address) == 0 &&
+		len(t.CAFile) == 0 &&
+		len(t.CAPath) == 0 &&
+		len(t.CertificateFile) == 0 &&
+		len(t.KeyFile) == 0 &&
+		len(t.CAPem) == 0 &&
+		len(t.CertificatePem) == 0 &&
+		len(t.KeyPem) == 0 &&
+		!t.InsecureSkipVerify &&
+		len(t.MinVersion) == 0 &&
+		len(t.CipherSuites) == 0
+}
+
+var (
+	// ErrInvalidTLSVersion indicates that TLSConfig.MinVersion named an unsupported TLS version.
+	ErrInvalidTLSVersion = errors.New("invalid TLS version")
+
+	// ErrInvalidCipherSuite indicates that TLSConfig.CipherSuites named an unknown or insecure cipher suite.
+	ErrInvalidCipherSuite = errors.New("invalid or insecure TLS cipher suite")
+
+	// ErrTLSPolicyUnixAddress indicates that TLSConfig.MinVersion or TLSConfig.CipherSuites was set
+	// for a unix socket address. api.NewClient replaces the transport for such an address, which
+	// would silently discard the policy.
+	ErrTLSPolicyUnixAddress = errors.New("a TLS minimum version or cipher suites cannot be used with a unix socket address")
+)
+
+// parseTLSVersion converts a configured TLS version into its crypto/tls constant.
+// Versions older than TLS 1.2 are rejected.
+func parseTLSVersion(v string) (uint16, error) {
+	switch strings.TrimPrefix(strings.ToLower(v), "tls") {
+	case "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", ErrInvalidTLSVersion, v)
+	}
+}
+
+// parseCipherSuites converts configured cipher suite names into their crypto/tls IDs.
+func parseCipherSuites(names []string) (ids []uint16, err error) {
+	for _, name := range names {
+		i := slices.IndexFunc(tls.CipherSuites(), func(cs *tls.CipherSuite) bool {
+			return cs.Name == name
+		})
+
+		if i < 0 {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidCipherSuite, name)
+		}
+
+		ids = append(ids, tls.CipherSuites()[i].ID)
+	}
+
+	return
+}
+
+// mergeTLSEnvironment fills in the TLS fields that api.NewClient takes from the
+// environment, e.g. CONSUL_CACERT and CONSUL_TLS_SERVER_NAME. api.NewClient only
+// does this merge when it builds the HTTP client itself.
+func mergeTLSEnvironment(dst *api.TLSConfig, defaults api.TLSConfig) {
+	defaultString(&dst.Address, defaults.Address)
+	defaultString(&dst.CAFile, defaults.CAFile)
+	defaultString(&dst.CAPath, defaults.CAPath)
+	defaultString(&dst.CertFile, defaults.CertFile)
+	defaultString(&dst.KeyFile, defaults.KeyFile)
+	dst.InsecureSkipVerify = dst.InsecureSkipVerify || defaults.InsecureSkipVerify
+}
+
+// applyTLSPolicy installs an HTTP client that enforces MinVersion and CipherSuites.
+// api.TLSConfig has no equivalent fields, so the client's transport must be set up
+// here. Since api.NewClient won't apply the environment's TLS settings to a supplied
+// HTTP client, they are merged into dst first. If neither field is set, dst is left
+// alone so that api.NewClient builds its usual client.
+func applyTLSPolicy(dst *api.Config, src TLSConfig) (err error) {
+	if len(src.MinVersion) == 0 && len(src.CipherSuites) == 0 {
+		return
+	}
+
+	defaults := api.DefaultConfig()
+	address := dst.Address
+	defaultString(&address, defaults.Address)
+	if strings.HasPrefix(address, "unix://") {
+		return ErrTLSPolicyUnixAddress
+	}
+
+	mergeTLSEnvironment(&dst.TLSConfig, defaults.TLSConfig)
+
+	var minVersion uint16
+	if len(src.MinVersion) > 0 {
+		if minVersion, err = parseTLSVersion(src.MinVersion); err != nil {
+			return
+		}
+	}
+
+	var cipherSuites []uint16
+	if cipherSuites, err = parseCipherSuites(src.CipherSuites); err != nil {
+		return
+	}
+
+	transport := cleanhttp.DefaultPooledTransport()
+	if dst.Transport != nil {
+		transport = dst.Transport.Clone()
+	}
+
+	// api.NewHttpClient only applies dst.TLSConfig to a transport with no TLS configuration
+	transport.TLSClientConfig = nil
+
+	var client *http.Client
+	if client, err = api.NewHttpClient(transport, dst.TLSConfig); err != nil {
+		return
+	}
+
+	transport.TLSClientConfig.MinVersion = minVersion
+	transport.TLSClientConfig.CipherSuites = cipherSuites
+	dst.HttpClient = client
+	return
+}
+
+// ErrInvalidPEM indicates that in-memory TLS material was neither PEM text nor
+// base64-encoded PEM.
+var ErrInvalidPEM = errors.New("TLS material must be PEM text or base64-encoded PEM")
+
+// decodePEM converts a configured PEM value into the bytes consul expects.
+// An empty value results in nil.
+func decodePEM(field, v string) ([]byte, error) {
+	if len(v) == 0 {
+		return nil, nil
+	}
+
+	data := []byte(v)
+	if !strings.Contains(v, "-----BEGIN ") {
+		var err error
+		data, err = base64.StdEncoding.DecodeString(strings.TrimSpace(v))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", field, ErrInvalidPEM)
+		}
+	}
+
+	if block, _ := pem.Decode(data); block == nil {
+		return nil, fmt.Errorf("%s: %w", field, ErrInvalidPEM)
+	}
+
+	return data, nil
+}
+
+// pems decodes the in-memory CA, certificate, and key material.
+func (t TLSConfig) pems() (caPem, certPem, keyPem []byte, err error) {
+	if caPem, err = decodePEM("tls.caPem", t.CAPem); err == nil {
+		if certPem, err = decodePEM("tls.certificatePem", t.CertificatePem); err == nil {
+			keyPem, err = decodePEM("tls.keyPem", t.KeyPem)
+		}
+	}
+
+	return
 }
 
 // Config is an easily unmarshalable configuration that praetor uses to create
@@ -73,6 +289,13 @@ type Config struct {
 	// TokenFile is a file containing the per request ACL token.
 	TokenFile string `json:"tokenFile" yaml:"tokenFile" mapstructure:"tokenFile"`
 
+	// TokenEnv is the name of an environment variable holding the per request ACL token.
+	// The variable is read once, when the consul api.Config is created, and must be set
+	// to a non-empty value.
+	// Token takes precedence over this field. When this field supplies the token, TokenFile
+	// is ignored.
+	TokenEnv string `json:"tokenEnv" yaml:"tokenEnv" mapstructure:"tokenEnv"`
+
 	// Namespace is the namespace to send to the agent in requests where no namespace is set.
 	Namespace string `json:"namespace" yaml:"namespace" mapstructure:"namespace"`
 
@@ -84,18 +307,65 @@ type Config struct {
 
 	// TLS defines the TLS configuration to use for the consul server.
 	TLS TLSConfig `json:"tls" yaml:"tls" mapstructure:"tls"`
+
+	// Login optionally obtains the ACL token by logging in to a consul auth method.
+	// If Login.AuthMethod is set, none of Token, TokenFile, or TokenEnv may be set.
+	Login LoginConfig `json:"login" yaml:"login" mapstructure:"login"`
+
+	// Datacenters holds per-datacenter overrides, keyed by datacenter name. Each entry
+	// describes a client for that datacenter. See ForDatacenter and ProvideDatacenterClients.
+	Datacenters map[string]DatacenterConfig `json:"datacenters" yaml:"datacenters" mapstructure:"datacenters"`
 }
 
+var (
+	// ErrTokenAndLogin indicates that a Config specified both a token and an auth method login.
+	ErrTokenAndLogin = errors.New("a token and an auth method login cannot both be configured")
+
+	// ErrTokenEnvNotSet indicates that the environment variable named by Config.TokenEnv was
+	// not set, or was set to an empty value.
+	ErrTokenEnvNotSet = errors.New("the token environment variable is not set")
+)
+
 // NewAPIConfig constructs a consul client api.Config from a praetor configuration.
+//
+// If the TLS configuration sets MinVersion or CipherSuites, the returned api.Config
+// has an HttpClient that enforces them. Any TLS files are read at that point, and the
+// TLS settings from consul's environment variables are applied to that HttpClient
+// just as api.NewClient would apply them. Such a policy cannot be used with a
+// unix socket address.
 func NewAPIConfig(src Config) (dst api.Config, err error) {
+	dst, err = newAPIConfig(src)
+	if err == nil {
+		err = applyTLSPolicy(&dst, src.TLS)
+	}
+
+	if err != nil {
+		dst = api.Config{}
+	}
+
+	return
+}
+
+// newAPIConfig does the field-by-field conversion for NewAPIConfig.
+func newAPIConfig(src Config) (dst api.Config, err error) {
+	var token, tokenFile string
+	if token, tokenFile, err = src.tokens(); err != nil {
+		return
+	}
+
+	var caPem, certPem, keyPem []byte
+	if caPem, certPem, keyPem, err = src.TLS.pems(); err != nil {
+		return
+	}
+
 	dst = api.Config{
 		Scheme:     src.Scheme,
 		Address:    src.Address,
 		PathPrefix: src.PathPrefix,
 		Datacenter: src.Datacenter,
 		WaitTime:   src.WaitTime,
-		Token:      src.Token,
-		TokenFile:  src.TokenFile,
+		Token:      token,
+		TokenFile:  tokenFile,
 		Namespace:  src.Namespace,
 		Partition:  src.Partition,
 		TLSConfig: api.TLSConfig{
@@ -104,16 +374,118 @@ func NewAPIConfig(src Config) (dst api.Config, err error) {
 			CAPath:             src.TLS.CAPath,
 			CertFile:           src.TLS.CertificateFile,
 			KeyFile:            src.TLS.KeyFile,
+			CAPem:              caPem,
+			CertPEM:            certPem,
+			KeyPEM:             keyPem,
 			InsecureSkipVerify: src.TLS.InsecureSkipVerify,
 		},
 	}
 
 	if len(src.BasicAuth.UserName) > 0 {
+		var password string
+		if password, err = src.BasicAuth.password(); err != nil {
+			dst = api.Config{}
+			return
+		}
+
 		dst.HttpAuth = &api.HttpBasicAuth{
 			Username: src.BasicAuth.UserName,
-			Password: src.BasicAuth.Password,
+			Password: password,
 		}
 	}
 
 	return
 }
+
+// tokens resolves the ACL token fields for api.Config. When TokenEnv supplies the
+// token, TokenFile is dropped, since api.NewClient prefers a token file over a token.
+func (c Config) tokens() (token, tokenFile string, err error) {
+	switch {
+	case len(c.Login.AuthMethod) > 0 && (len(c.Token) > 0 || len(c.TokenFile) > 0 || len(c.TokenEnv) > 0):
+		err = ErrTokenAndLogin
+
+	case len(c.Token) > 0 || len(c.TokenEnv) == 0:
+		token, tokenFile = c.Token, c.TokenFile
+
+	default:
+		// an empty token would let NewDefaultAPIConfig fall back to CONSUL_HTTP_TOKEN
+		if token = os.Getenv(c.TokenEnv); len(token) == 0 {
+			err = fmt.Errorf("%w: %s", ErrTokenEnvNotSet, c.TokenEnv)
+		}
+	}
+
+	return
+}
+
+// NewDefaultAPIConfig constructs a consul client api.Config by layering a praetor
+// configuration on top of api.DefaultConfig. This honors the standard consul
+// environment variables, e.g. CONSUL_HTTP_ADDR and CONSUL_HTTP_TOKEN, for any
+// field that the praetor configuration leaves unset.
+//
+// Token and TokenFile are treated as a unit: if src sets either, or if src logs
+// in with an auth method, any token from the environment is discarded.
+func NewDefaultAPIConfig(src Config) (dst api.Config, err error) {
+	var overlay api.Config
+	overlay, err = newAPIConfig(src)
+	if err != nil {
+		return
+	}
+
+	dst = *api.DefaultConfig()
+	overlayString(&dst.Scheme, overlay.Scheme)
+	overlayString(&dst.Address, overlay.Address)
+	overlayString(&dst.PathPrefix, overlay.PathPrefix)
+	overlayString(&dst.Datacenter, overlay.Datacenter)
+	overlayString(&dst.Namespace, overlay.Namespace)
+	overlayString(&dst.Partition, overlay.Partition)
+	if overlay.WaitTime > 0 {
+		dst.WaitTime = overlay.WaitTime
+	}
+
+	if len(overlay.Token) > 0 || len(overlay.TokenFile) > 0 || len(src.Login.AuthMethod) > 0 {
+		dst.Token, dst.TokenFile = overlay.Token, overlay.TokenFile
+	}
+
+	if overlay.HttpAuth != nil {
+		dst.HttpAuth = overlay.HttpAuth
+	}
+
+	overlayString(&dst.TLSConfig.Address, overlay.TLSConfig.Address)
+	overlayString(&dst.TLSConfig.CAFile, overlay.TLSConfig.CAFile)
+	overlayString(&dst.TLSConfig.CAPath, overlay.TLSConfig.CAPath)
+	overlayString(&dst.TLSConfig.CertFile, overlay.TLSConfig.CertFile)
+	overlayString(&dst.TLSConfig.KeyFile, overlay.TLSConfig.KeyFile)
+	overlayBytes(&dst.TLSConfig.CAPem, overlay.TLSConfig.CAPem)
+	overlayBytes(&dst.TLSConfig.CertPEM, overlay.TLSConfig.CertPEM)
+	overlayBytes(&dst.TLSConfig.KeyPEM, overlay.TLSConfig.KeyPEM)
+	if overlay.TLSConfig.InsecureSkipVerify {
+		dst.TLSConfig.InsecureSkipVerify = true
+	}
+
+	if err = applyTLSPolicy(&dst, src.TLS); err != nil {
+		dst = api.Config{}
+	}
+
+	return
+}
+
+// overlayString replaces *dst with v when v is set.
+func overlayString(dst *string, v string) {
+	if len(v) > 0 {
+		*dst = v
+	}
+}
+
+// defaultString replaces *dst with v when *dst is unset.
+func defaultString(dst *string, v string) {
+	if len(*dst) == 0 {
+		*dst = v
+	}
+}
+
+// overlayBytes replaces *dst with v when v is set.
+func overlayBytes(dst *[]byte, v []byte) {
+	if len(v) > 0 {
+		*dst = v
+	}
+}
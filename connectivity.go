@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"go.uber.org/fx"
+)
+
+// DefaultConnectivityInterval is the time between connectivity checks when
+// no Interval is configured.
+const DefaultConnectivityInterval = 10 * time.Second
+
+// ConnectivityConfig configures a ConnectivityMonitor.
+type ConnectivityConfig struct {
+	// Interval is the time between connectivity checks. If unset,
+	// DefaultConnectivityInterval is used.
+	Interval time.Duration `json:"interval" yaml:"interval" mapstructure:"interval"`
+
+	// Options are the query options used for each check, e.g. for datacenter
+	// or token. The context is managed by the monitor.
+	Options api.QueryOptions `json:"-" yaml:"-" mapstructure:"-"`
+}
+
+// ConnectivityEvent describes whether consul is reachable.
+type ConnectivityEvent struct {
+	// Reachable is true if consul answered the most recent check.
+	Reachable bool
+
+	// Err is the error from the most recent check. This is nil if and only if
+	// Reachable is true.
+	Err error
+}
+
+// ConnectivityListener is a sink for ConnectivityEvents.
+type ConnectivityListener func(ConnectivityEvent)
+
+// ConnectivityMonitor periodically checks whether consul can be reached by
+// asking for the current raft leader. Any answer, including a report that the
+// cluster has no leader, counts as reachable. Use a ClusterHealthMonitor to
+// judge the health of the cluster itself.
+//
+// Listeners are notified only when consul becomes reachable or unreachable.
+type ConnectivityMonitor struct {
+	status *api.Status
+	cfg    ConnectivityConfig
+
+	listeners listeners[ConnectivityListener]
+
+	lock sync.Mutex
+	last ConnectivityEvent
+
+	poller poller[ConnectivityEvent]
+}
+
+// NewConnectivityMonitor creates a ConnectivityMonitor. The returned monitor
+// must be started before it will check connectivity.
+func NewConnectivityMonitor(client *api.Client, cfg ConnectivityConfig) *ConnectivityMonitor {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultConnectivityInterval
+	}
+
+	return &ConnectivityMonitor{
+		status: client.Status(),
+		cfg:    cfg,
+		poller: poller[ConnectivityEvent]{interval: cfg.Interval},
+	}
+}
+
+// AddListener registers a listener for subsequent events. The returned
+// function removes the listener.
+func (m *ConnectivityMonitor) AddListener(l ConnectivityListener) (cancel func()) {
+	return m.listeners.add(l)
+}
+
+// Reachable returns whether consul answered the most recent check. This method
+// returns false before the monitor is started.
+func (m *ConnectivityMonitor) Reachable() bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.last.Reachable
+}
+
+// LastError returns the error from the most recent check, or nil if that
+// check succeeded.
+func (m *ConnectivityMonitor) LastError() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.last.Err
+}
+
+// Start performs an initial check and dispatches its result, then begins checking
+// periodically in a background goroutine. A failed initial check is dispatched as
+// an event and does not prevent the monitor from starting.
+func (m *ConnectivityMonitor) Start(ctx context.Context) error {
+	return m.poller.start(ctx, m.check, m.initial, m.next)
+}
+
+// Stop halts the background goroutine started by Start, waiting until it exits
+// or the given context is canceled. This method is idempotent.
+func (m *ConnectivityMonitor) Stop(ctx context.Context) error {
+	return m.poller.stop(ctx)
+}
+
+// check asks consul for the current leader.
+func (m *ConnectivityMonitor) check(ctx context.Context) (e ConnectivityEvent) {
+	q := m.cfg.Options
+	q.WaitIndex = 0
+	_, e.Err = m.status.LeaderWithQueryOptions(q.WithContext(ctx))
+	e.Reachable = e.Err == nil
+	return
+}
+
+// record stores the result of a check.
+func (m *ConnectivityMonitor) record(e ConnectivityEvent) {
+	m.lock.Lock()
+	m.last = e
+	m.lock.Unlock()
+}
+
+// dispatch sends an event to all listeners.
+func (m *ConnectivityMonitor) dispatch(e ConnectivityEvent) {
+	m.listeners.visit(func(l ConnectivityListener) {
+		l(e)
+	})
+}
+
+// initial handles the result of the check made by Start.
+func (m *ConnectivityMonitor) initial(e ConnectivityEvent) {
+	m.record(e)
+	m.dispatch(e)
+}
+
+// next handles the result of each subsequent check. Every check is recorded,
+// but only transitions are dispatched.
+func (m *ConnectivityMonitor) next(e ConnectivityEvent) {
+	changed := e.Reachable != m.Reachable()
+	m.record(e)
+	if changed {
+		m.dispatch(e)
+	}
+}
+
+// ProvideConnectivityMonitor emits a *ConnectivityMonitor bound to the enclosing
+// application's lifecycle. The monitor's initial check happens when the application
+// starts, and the monitor is stopped when the application stops.
+//
+// This provider requires an *api.Client, such as the one emitted by Provide.
+func ProvideConnectivityMonitor(cfg ConnectivityConfig) fx.Option {
+	return fx.Provide(
+		func(client *api.Client, l fx.Lifecycle) *ConnectivityMonitor {
+			m := NewConnectivityMonitor(client, cfg)
+			l.Append(fx.StartStopHook(m.Start, m.Stop))
+			return m
+		},
+	)
+}
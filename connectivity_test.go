@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/praetor/praetortest"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+type ConnectivitySuite struct {
+	suite.Suite
+}
+
+func (suite *ConnectivitySuite) nextEvent(events <-chan ConnectivityEvent) ConnectivityEvent {
+	select {
+	case e := <-events:
+		return e
+
+	case <-time.After(5 * time.Second):
+		suite.FailNow("no connectivity event")
+		return ConnectivityEvent{}
+	}
+}
+
+func (suite *ConnectivitySuite) TestDefaults() {
+	consul := praetortest.NewServer(suite.T())
+	m := NewConnectivityMonitor(consul.Client(suite.T()), ConnectivityConfig{})
+	suite.Equal(DefaultConnectivityInterval, m.cfg.Interval)
+	suite.False(m.Reachable())
+	suite.NoError(m.LastError())
+	suite.NoError(m.Stop(context.Background()))
+}
+
+func (suite *ConnectivitySuite) TestTransitions() {
+	var (
+		down   atomic.Bool
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if down.Load() {
+				http.Error(w, "unavailable", http.StatusServiceUnavailable)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`""`))
+		}))
+	)
+
+	defer server.Close()
+	client, err := api.NewClient(&api.Config{Address: server.Listener.Addr().String()})
+	suite.Require().NoError(err)
+
+	m := NewConnectivityMonitor(client, ConnectivityConfig{Interval: 10 * time.Millisecond})
+	events := make(chan ConnectivityEvent, 10)
+	m.AddListener(func(e ConnectivityEvent) {
+		events <- e
+	})
+
+	suite.Require().NoError(m.Start(context.Background()))
+	defer m.Stop(context.Background())
+	suite.ErrorIs(m.Start(context.Background()), ErrAlreadyStarted)
+
+	e := suite.nextEvent(events)
+	suite.True(e.Reachable)
+	suite.NoError(e.Err)
+	suite.True(m.Reachable())
+
+	down.Store(true)
+	e = suite.nextEvent(events)
+	suite.False(e.Reachable)
+	suite.Error(e.Err)
+	suite.False(m.Reachable())
+	suite.Error(m.LastError())
+
+	// repeated failures are not dispatched
+	select {
+	case e := <-events:
+		suite.Failf("duplicate event", "%v", e)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	down.Store(false)
+	e = suite.nextEvent(events)
+	suite.True(e.Reachable)
+	suite.NoError(m.LastError())
+
+	suite.NoError(m.Stop(context.Background()))
+	suite.NoError(m.Stop(context.Background()))
+}
+
+func (suite *ConnectivitySuite) TestProvideConnectivityMonitor() {
+	consul := praetortest.NewServer(suite.T())
+
+	var m *ConnectivityMonitor
+	app := fxtest.New(
+		suite.T(),
+		fx.Supply(consul.Client(suite.T())),
+		ProvideConnectivityMonitor(ConnectivityConfig{}),
+		fx.Populate(&m),
+	)
+
+	suite.Require().NotNil(m)
+	app.RequireStart()
+	suite.True(m.Reachable())
+	app.RequireStop()
+}
+
+func TestConnectivity(t *testing.T) {
+	suite.Run(t, new(ConnectivitySuite))
+}
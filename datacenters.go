@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/consul/api"
+	"go.uber.org/fx"
+)
+
+// ErrDatacenterTokenRequired indicates that the base Config logs in with an auth method,
+// but a datacenter has no token of its own. The login token belongs to the base client,
+// so it is not shared with datacenter clients.
+var ErrDatacenterTokenRequired = errors.New("a datacenter must configure its own token when the base config uses a login")
+
+// DatacenterConfig overrides parts of a Config for a single datacenter. Unset
+// fields fall back to the enclosing Config.
+type DatacenterConfig struct {
+	// Scheme is the URI scheme of this datacenter's consul server.
+	Scheme string `json:"scheme" yaml:"scheme" mapstructure:"scheme"`
+
+	// Address is the address of this datacenter's consul server, including port.
+	// If unset, requests go through the base address and are forwarded by consul.
+	Address string `json:"address" yaml:"address" mapstructure:"address"`
+
+	// Token is the ACL token used in this datacenter.
+	Token string `json:"token" yaml:"token" mapstructure:"token"`
+
+	// TokenFile is a file containing the ACL token used in this datacenter.
+	TokenFile string `json:"tokenFile" yaml:"tokenFile" mapstructure:"tokenFile"`
+
+	// TokenEnv is the name of an environment variable holding the ACL token used
+	// in this datacenter.
+	TokenEnv string `json:"tokenEnv" yaml:"tokenEnv" mapstructure:"tokenEnv"`
+
+	// TLS is the TLS configuration for this datacenter. If any field is set, this
+	// replaces the base TLS configuration entirely.
+	TLS TLSConfig `json:"tls" yaml:"tls" mapstructure:"tls"`
+}
+
+// hasToken tests if this datacenter overrides any of the token fields.
+func (dc DatacenterConfig) hasToken() bool {
+	return len(dc.Token) > 0 || len(dc.TokenFile) > 0 || len(dc.TokenEnv) > 0
+}
+
+// ForDatacenter returns the Config for the given datacenter, which is this Config
+// with the datacenter's overrides applied. The returned Config has no Datacenters
+// and no Login, since a login is bound to the base client's lifecycle. If any token
+// field is overridden, all of the base token fields are replaced.
+//
+// When this Config has a Login, the datacenter should override a token field.
+// Otherwise, the returned Config has no ACL token. NewDatacenterClients and
+// ProvideDatacenterClients return ErrDatacenterTokenRequired in that case.
+//
+// A datacenter that has no entry in Datacenters gets this Config with only the
+// Datacenter field changed.
+func (c Config) ForDatacenter(name string) Config {
+	dc := c.Datacenters[name]
+	c.Datacenter = name
+	c.Datacenters = nil
+	c.Login = LoginConfig{}
+
+	overlayString(&c.Scheme, dc.Scheme)
+	overlayString(&c.Address, dc.Address)
+	if dc.hasToken() {
+		c.Token, c.TokenFile, c.TokenEnv = dc.Token, dc.TokenFile, dc.TokenEnv
+	}
+
+	if !dc.TLS.isZero() {
+		c.TLS = dc.TLS
+	}
+
+	return c
+}
+
+// NewDatacenterClients creates a consul client for each datacenter in src.Datacenters.
+func NewDatacenterClients(src Config) (map[string]*api.Client, error) {
+	clients, _, err := newDatacenterClients(src)
+	return clients, err
+}
+
+// newDatacenterClients creates the datacenter clients along with the HTTP clients
+// they use, which api.Client does not expose. If any client cannot be created, the
+// idle connections of the clients already created are closed.
+func newDatacenterClients(src Config) (clients map[string]*api.Client, httpClients []*http.Client, err error) {
+	clients = make(map[string]*api.Client, len(src.Datacenters))
+	for name, dc := range src.Datacenters {
+		var cfg api.Config
+		if len(src.Login.AuthMethod) > 0 && !dc.hasToken() {
+			err = ErrDatacenterTokenRequired
+		} else {
+			cfg, err = NewAPIConfig(src.ForDatacenter(name))
+		}
+
+		if err == nil {
+			clients[name], err = api.NewClient(&cfg)
+		}
+
+		if err != nil {
+			for _, hc := range httpClients {
+				hc.CloseIdleConnections()
+			}
+
+			return nil, nil, fmt.Errorf("datacenter %s: %w", name, err)
+		}
+
+		// api.NewClient fills in the HTTP client when the config doesn't supply one
+		httpClients = append(httpClients, cfg.HttpClient)
+	}
+
+	return
+}
+
+// ProvideDatacenterClients emits a map[string]*api.Client with a client for each
+// entry in the praetor Config's Datacenters. These clients are separate from the
+// *api.Client emitted by Provide. When the application stops, idle connections
+// held by each client's HTTP transport are closed.
+func ProvideDatacenterClients() fx.Option {
+	return fx.Provide(
+		func(src Config, l fx.Lifecycle) (map[string]*api.Client, error) {
+			clients, httpClients, err := newDatacenterClients(src)
+			for _, hc := range httpClients {
+				l.Append(fx.StopHook(hc.CloseIdleConnections))
+			}
+
+			return clients, err
+		},
+	)
+}
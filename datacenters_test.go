@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"testing"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/praetor/praetortest"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+type DatacentersSuite struct {
+	suite.Suite
+}
+
+func (suite *DatacentersSuite) TestForDatacenter() {
+	base := Config{
+		Scheme:     "https",
+		Address:    "consul.example.com:8501",
+		Datacenter: "dc1",
+		Token:      "base-token",
+		TokenFile:  "/etc/app/token",
+		Namespace:  "namespace",
+		TLS: TLSConfig{
+			CAFile: "/etc/app/ca.pem",
+		},
+		Login: LoginConfig{
+			AuthMethod: "jwt",
+		},
+		Datacenters: map[string]DatacenterConfig{
+			"dc2": {
+				Address: "consul.dc2.example.com:8501",
+				Token:   "dc2-token",
+				TLS: TLSConfig{
+					CAFile: "/etc/app/dc2-ca.pem",
+				},
+			},
+			"dc3": {},
+			"dc4": {
+				TLS: TLSConfig{
+					CipherSuites: []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"},
+				},
+			},
+		},
+	}
+
+	dc2 := base.ForDatacenter("dc2")
+	suite.Equal(
+		Config{
+			Scheme:     "https",
+			Address:    "consul.dc2.example.com:8501",
+			Datacenter: "dc2",
+			Token:      "dc2-token",
+			Namespace:  "namespace",
+			TLS: TLSConfig{
+				CAFile: "/etc/app/dc2-ca.pem",
+			},
+		},
+		dc2,
+	)
+
+	dc3 := base.ForDatacenter("dc3")
+	suite.Equal(
+		Config{
+			Scheme:     "https",
+			Address:    "consul.example.com:8501",
+			Datacenter: "dc3",
+			Token:      "base-token",
+			TokenFile:  "/etc/app/token",
+			Namespace:  "namespace",
+			TLS: TLSConfig{
+				CAFile: "/etc/app/ca.pem",
+			},
+		},
+		dc3,
+	)
+
+	dc4 := base.ForDatacenter("dc4")
+	suite.Equal(TLSConfig{CipherSuites: []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"}}, dc4.TLS)
+
+	suite.Equal("dc1", base.Datacenter, "the base config should not be modified")
+	suite.Len(base.Datacenters, 3)
+}
+
+func (suite *DatacentersSuite) TestNewDatacenterClients() {
+	var (
+		dc2 = praetortest.NewServer(suite.T())
+		dc3 = praetortest.NewServer(suite.T())
+	)
+
+	dc2.SetKV("name", []byte("dc2"))
+	dc3.SetKV("name", []byte("dc3"))
+
+	clients, err := NewDatacenterClients(Config{
+		Scheme: "http",
+		Datacenters: map[string]DatacenterConfig{
+			"dc2": {Address: dc2.Address()},
+			"dc3": {Address: dc3.Address()},
+		},
+	})
+
+	suite.Require().NoError(err)
+	suite.Require().Len(clients, 2)
+	for name, client := range clients {
+		pair, _, err := client.KV().Get("name", nil)
+		suite.Require().NoError(err)
+		suite.Require().NotNil(pair)
+		suite.Equal(name, string(pair.Value))
+	}
+
+	clients, err = NewDatacenterClients(Config{})
+	suite.NoError(err)
+	suite.Empty(clients)
+}
+
+func (suite *DatacentersSuite) TestNewDatacenterClientsError() {
+	clients, err := NewDatacenterClients(Config{
+		Datacenters: map[string]DatacenterConfig{
+			"dc2": {TokenEnv: "PRAETOR_TEST_MISSING"},
+		},
+	})
+
+	suite.ErrorIs(err, ErrTokenEnvNotSet)
+	suite.ErrorContains(err, "dc2")
+	suite.Nil(clients)
+}
+
+func (suite *DatacentersSuite) TestNewDatacenterClientsLogin() {
+	dc2 := praetortest.NewServer(suite.T())
+	src := Config{
+		Scheme: "http",
+		Login: LoginConfig{
+			AuthMethod: "jwt",
+		},
+		Datacenters: map[string]DatacenterConfig{
+			"dc2": {Address: dc2.Address()},
+		},
+	}
+
+	clients, err := NewDatacenterClients(src)
+	suite.ErrorIs(err, ErrDatacenterTokenRequired)
+	suite.ErrorContains(err, "dc2")
+	suite.Nil(clients)
+
+	src.Datacenters["dc2"] = DatacenterConfig{
+		Address: dc2.Address(),
+		Token:   "dc2-token",
+	}
+
+	clients, err = NewDatacenterClients(src)
+	suite.Require().NoError(err)
+	suite.Contains(clients, "dc2")
+}
+
+func (suite *DatacentersSuite) TestProvideDatacenterClients() {
+	dc2 := praetortest.NewServer(suite.T())
+
+	var clients map[string]*api.Client
+	app := fxtest.New(
+		suite.T(),
+		fx.Supply(Config{
+			Scheme: "http",
+			Datacenters: map[string]DatacenterConfig{
+				"dc2": {Address: dc2.Address()},
+			},
+		}),
+		ProvideDatacenterClients(),
+		fx.Populate(&clients),
+	)
+
+	app.RequireStart()
+	suite.Require().Contains(clients, "dc2")
+	_, err := clients["dc2"].Status().Leader()
+	suite.NoError(err)
+	app.RequireStop()
+}
+
+func TestDatacenters(t *testing.T) {
+	suite.Run(t, new(DatacentersSuite))
+}
@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"go.uber.org/fx"
+)
+
+// DefaultElectorRetryInterval is the time an Elector waits after a consul error
+// before attempting to acquire leadership again.
+const DefaultElectorRetryInterval = 5 * time.Second
+
+// ElectorConfig describes a leader election. Every participant in the same election
+// must use the same Key.
+type ElectorConfig struct {
+	// Key is the consul key used as the leadership lock. This field is required.
+	Key string `json:"key" yaml:"key" mapstructure:"key"`
+
+	// Value is the optional value stored at Key while this instance is the leader.
+	// Typically, this identifies the leader to other participants.
+	Value string `json:"value" yaml:"value" mapstructure:"value"`
+
+	// SessionName is the name of the consul session used to hold the lock.
+	// If unset, the consul api package's default is used.
+	SessionName string `json:"sessionName" yaml:"sessionName" mapstructure:"sessionName"`
+
+	// SessionTTL is the TTL of the consul session used to hold the lock. The session
+	// is renewed automatically. If unset, the consul api package's default is used.
+	SessionTTL time.Duration `json:"sessionTTL" yaml:"sessionTTL" mapstructure:"sessionTTL"`
+
+	// LockDelay is the time consul prevents the lock from being reacquired after
+	// the session holding it is invalidated. If unset, consul's default is used.
+	LockDelay time.Duration `json:"lockDelay" yaml:"lockDelay" mapstructure:"lockDelay"`
+
+	// RetryInterval is the time to wait after a consul error before trying to
+	// acquire leadership again. If unset, DefaultElectorRetryInterval is used.
+	RetryInterval time.Duration `json:"retryInterval" yaml:"retryInterval" mapstructure:"retryInterval"`
+}
+
+// LeadershipEvent describes a change in leadership for an Elector.
+type LeadershipEvent struct {
+	// Key is the election's lock key.
+	Key string
+
+	// Leader indicates whether this instance is now the leader.
+	Leader bool
+}
+
+// LeadershipListener is a sink for LeadershipEvents.
+type LeadershipListener func(LeadershipEvent)
+
+// Elector participates in a consul leader election. Leadership is held by
+// acquiring a KV lock with a consul session that the Elector keeps renewed.
+type Elector struct {
+	key           string
+	lock          *api.Lock
+	retryInterval time.Duration
+	listeners     listeners[LeadershipListener]
+
+	stateLock sync.Mutex
+	leader    bool
+	err       error
+
+	runner runner
+}
+
+// NewElector creates an Elector for the given configuration. The returned
+// Elector does not participate in the election until it is started.
+func NewElector(client *api.Client, cfg ElectorConfig) (*Elector, error) {
+	opts := &api.LockOptions{
+		Key:         cfg.Key,
+		SessionName: cfg.SessionName,
+		LockDelay:   cfg.LockDelay,
+	}
+
+	if len(cfg.Value) > 0 {
+		opts.Value = []byte(cfg.Value)
+	}
+
+	if cfg.SessionTTL > 0 {
+		opts.SessionTTL = cfg.SessionTTL.String()
+	}
+
+	lock, err := client.LockOpts(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Elector{
+		key:           cfg.Key,
+		lock:          lock,
+		retryInterval: cfg.RetryInterval,
+	}
+
+	if e.retryInterval <= 0 {
+		e.retryInterval = DefaultElectorRetryInterval
+	}
+
+	return e, nil
+}
+
+// IsLeader tests if this instance currently holds leadership.
+func (e *Elector) IsLeader() bool {
+	e.stateLock.Lock()
+	defer e.stateLock.Unlock()
+	return e.leader
+}
+
+// Err returns the most recent error encountered while trying to acquire
+// leadership, or nil if the most recent attempt succeeded.
+func (e *Elector) Err() error {
+	e.stateLock.Lock()
+	defer e.stateLock.Unlock()
+	return e.err
+}
+
+// AddListener registers a listener for changes in leadership. The returned
+// function removes the listener.
+func (e *Elector) AddListener(l LeadershipListener) (cancel func()) {
+	return e.listeners.add(l)
+}
+
+// Start begins participating in the election in a background goroutine.
+func (e *Elector) Start(context.Context) error {
+	return e.runner.start(func() (func(context.Context), error) {
+		return e.run, nil
+	})
+}
+
+// Stop leaves the election, releasing leadership if this instance holds it.
+// This method waits for the background goroutine to exit or for the context
+// to be canceled. This method is idempotent.
+func (e *Elector) Stop(ctx context.Context) error {
+	return e.runner.stop(ctx)
+}
+
+// setState updates the leadership state, dispatching an event if it changed.
+func (e *Elector) setState(leader bool, err error) {
+	e.stateLock.Lock()
+	changed := e.leader != leader
+	e.leader, e.err = leader, err
+	e.stateLock.Unlock()
+
+	if changed {
+		event := LeadershipEvent{
+			Key:    e.key,
+			Leader: leader,
+		}
+
+		e.listeners.visit(func(l LeadershipListener) {
+			l(event)
+		})
+	}
+}
+
+// run is the background election loop.
+func (e *Elector) run(ctx context.Context) {
+	stop := ctx.Done()
+	for {
+		lost, err := e.lock.Lock(stop)
+		switch {
+		case err != nil:
+			e.setState(false, err)
+			select {
+			case <-stop:
+				return
+			case <-time.After(e.retryInterval):
+			}
+
+		case lost == nil:
+			// stopped before acquiring leadership
+			return
+
+		default:
+			e.setState(true, nil)
+			select {
+			case <-lost:
+				// always unlock, as that cleans up the lock's session renewal
+				e.lock.Unlock()
+				e.setState(false, nil)
+
+			case <-stop:
+				e.lock.Unlock()
+				e.setState(false, nil)
+				return
+			}
+		}
+	}
+}
+
+// ProvideElector emits an *Elector with the given name, bound to the enclosing
+// application's lifecycle. The elector joins the election when the application
+// starts and leaves it when the application stops.
+//
+// This provider requires an *api.Client, such as the one emitted by Provide.
+func ProvideElector(name string, cfg ElectorConfig) fx.Option {
+	return fx.Provide(
+		fx.Annotate(
+			func(client *api.Client, l fx.Lifecycle) (*Elector, error) {
+				e, err := NewElector(client, cfg)
+				if err == nil {
+					l.Append(fx.StartStopHook(e.Start, e.Stop))
+				}
+
+				return e, err
+			},
+			fx.ResultTags(`name:"`+name+`"`),
+		),
+	)
+}
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/praetor/praetortest"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+type ElectorSuite struct {
+	suite.Suite
+
+	consul *praetortest.Server
+	client *api.Client
+}
+
+func (suite *ElectorSuite) SetupTest() {
+	suite.consul = praetortest.NewServer(suite.T())
+	suite.client = suite.consul.Client(suite.T())
+}
+
+func (suite *ElectorSuite) newElector(cfg ElectorConfig) (*Elector, <-chan LeadershipEvent) {
+	e, err := NewElector(suite.client, cfg)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(e)
+
+	events := make(chan LeadershipEvent, 10)
+	e.AddListener(func(event LeadershipEvent) {
+		events <- event
+	})
+
+	return e, events
+}
+
+func (suite *ElectorSuite) nextEvent(events <-chan LeadershipEvent) LeadershipEvent {
+	select {
+	case event := <-events:
+		return event
+
+	case <-time.After(5 * time.Second):
+		suite.FailNow("no leadership event")
+		return LeadershipEvent{}
+	}
+}
+
+func (suite *ElectorSuite) TestNewElectorInvalid() {
+	e, err := NewElector(suite.client, ElectorConfig{})
+	suite.Error(err)
+	suite.Nil(e)
+}
+
+func (suite *ElectorSuite) TestLeadership() {
+	e, events := suite.newElector(ElectorConfig{
+		Key:        "service/leader",
+		Value:      "instance-1",
+		SessionTTL: time.Minute,
+	})
+
+	suite.False(e.IsLeader())
+	suite.Require().NoError(e.Start(context.Background()))
+	suite.ErrorIs(e.Start(context.Background()), ErrAlreadyStarted)
+
+	suite.Equal(LeadershipEvent{Key: "service/leader", Leader: true}, suite.nextEvent(events))
+	suite.True(e.IsLeader())
+	suite.NoError(e.Err())
+
+	pair := suite.consul.KV("service/leader")
+	suite.Require().NotNil(pair)
+	suite.Equal("instance-1", string(pair.Value))
+	suite.NotEmpty(pair.Session)
+
+	// simulate consul invalidating our session
+	suite.consul.InvalidateSession(pair.Session)
+	suite.Equal(LeadershipEvent{Key: "service/leader", Leader: false}, suite.nextEvent(events))
+
+	// the elector should reacquire leadership with a new session
+	suite.Equal(LeadershipEvent{Key: "service/leader", Leader: true}, suite.nextEvent(events))
+	suite.True(e.IsLeader())
+
+	suite.NoError(e.Stop(context.Background()))
+	suite.Equal(LeadershipEvent{Key: "service/leader", Leader: false}, suite.nextEvent(events))
+	suite.False(e.IsLeader())
+	suite.Empty(suite.consul.KV("service/leader").Session)
+	suite.NoError(e.Stop(context.Background()))
+}
+
+func (suite *ElectorSuite) TestFollower() {
+	leader, leaderEvents := suite.newElector(ElectorConfig{Key: "leader"})
+	follower, followerEvents := suite.newElector(ElectorConfig{Key: "leader"})
+
+	suite.Require().NoError(leader.Start(context.Background()))
+	suite.True(suite.nextEvent(leaderEvents).Leader)
+
+	suite.Require().NoError(follower.Start(context.Background()))
+	suite.False(follower.IsLeader())
+
+	suite.NoError(leader.Stop(context.Background()))
+	suite.False(suite.nextEvent(leaderEvents).Leader)
+	suite.True(suite.nextEvent(followerEvents).Leader)
+
+	suite.NoError(follower.Stop(context.Background()))
+}
+
+func (suite *ElectorSuite) TestConsulError() {
+	client, err := api.NewClient(&api.Config{
+		Address: "127.0.0.1:1",
+	})
+
+	suite.Require().NoError(err)
+	e, err := NewElector(client, ElectorConfig{
+		Key:           "leader",
+		RetryInterval: time.Millisecond,
+	})
+
+	suite.Require().NoError(err)
+	suite.Require().NoError(e.Start(context.Background()))
+	suite.Eventually(
+		func() bool { return e.Err() != nil },
+		5*time.Second,
+		10*time.Millisecond,
+	)
+
+	suite.False(e.IsLeader())
+	suite.NoError(e.Stop(context.Background()))
+}
+
+func (suite *ElectorSuite) TestProvideElector() {
+	var (
+		e *Elector
+
+		app = fxtest.New(
+			suite.T(),
+			fx.Supply(suite.client),
+			ProvideElector("test", ElectorConfig{Key: "provided"}),
+			fx.Populate(
+				fx.Annotate(&e, fx.ParamTags(`name:"test"`)),
+			),
+		)
+	)
+
+	suite.Require().NotNil(e)
+	app.RequireStart()
+	suite.Eventually(e.IsLeader, 5*time.Second, 10*time.Millisecond)
+	app.RequireStop()
+	suite.False(e.IsLeader())
+}
+
+func (suite *ElectorSuite) TestProvideElectorError() {
+	app := fx.New(
+		fx.NopLogger,
+		fx.Supply(suite.client),
+		ProvideElector("test", ElectorConfig{}),
+		fx.Invoke(
+			fx.Annotate(func(*Elector) {}, fx.ParamTags(`name:"test"`)),
+		),
+	)
+
+	suite.Error(app.Err())
+}
+
+func TestElector(t *testing.T) {
+	suite.Run(t, new(ElectorSuite))
+}
@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"go.uber.org/fx"
+)
+
+// FeatureFlagsConfig describes the consul key prefix that holds feature flags.
+type FeatureFlagsConfig struct {
+	// Prefix is the consul key prefix. Each key under this prefix is a flag, named
+	// by the remainder of the key. For example, with a prefix of "flags/myapp/", the
+	// key "flags/myapp/newRouting" holds the flag "newRouting".
+	Prefix string `json:"prefix" yaml:"prefix" mapstructure:"prefix"`
+
+	// RetryInterval is the time to wait before retrying a failed watch query.
+	// If unset, DefaultKVWatchRetryInterval is used.
+	RetryInterval time.Duration `json:"retryInterval" yaml:"retryInterval" mapstructure:"retryInterval"`
+
+	// Options are the base query options used to watch the prefix.
+	Options api.QueryOptions `json:"-" yaml:"-" mapstructure:"-"`
+}
+
+// FeatureFlagChange describes a single flag that changed.
+type FeatureFlagChange struct {
+	// Name is the flag's name, relative to the prefix.
+	Name string
+
+	// Value is the flag's new value. This is empty if the flag was deleted.
+	Value string
+
+	// Previous is the flag's prior value. This is empty if the flag is new.
+	Previous string
+
+	// Deleted indicates whether the flag's key was removed from consul.
+	Deleted bool
+}
+
+// FeatureFlagListener is a sink for FeatureFlagChanges.
+type FeatureFlagListener func(FeatureFlagChange)
+
+// FeatureFlags holds the current values of flags stored under a consul key prefix.
+// Values are plain strings, with leading and trailing whitespace removed. Getters take
+// a default that is returned when a flag is missing or can't be parsed, so an application
+// behaves sensibly before an operator has created any flags.
+type FeatureFlags struct {
+	prefix    string
+	listeners listeners[FeatureFlagListener]
+
+	lock   sync.Mutex
+	values map[string]string
+}
+
+// NewFeatureFlags creates an empty FeatureFlags for the given key prefix. The flags
+// are populated by attaching OnEvent to a KVWatcher for that prefix.
+func NewFeatureFlags(prefix string) *FeatureFlags {
+	return &FeatureFlags{
+		prefix: prefix,
+		values: make(map[string]string),
+	}
+}
+
+// Lookup returns the raw value of a flag and whether that flag exists.
+func (ff *FeatureFlags) Lookup(name string) (value string, exists bool) {
+	ff.lock.Lock()
+	defer ff.lock.Unlock()
+	value, exists = ff.values[name]
+	return
+}
+
+// String returns the value of a flag, or def if the flag does not exist.
+func (ff *FeatureFlags) String(name, def string) string {
+	if value, exists := ff.Lookup(name); exists {
+		return value
+	}
+
+	return def
+}
+
+// Bool returns the value of a flag as parsed by strconv.ParseBool. If the flag
+// does not exist or is not a valid boolean, def is returned.
+func (ff *FeatureFlags) Bool(name string, def bool) bool {
+	if value, exists := ff.Lookup(name); exists {
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+
+	return def
+}
+
+// Names returns the sorted names of all current flags.
+func (ff *FeatureFlags) Names() (names []string) {
+	ff.lock.Lock()
+	defer ff.lock.Unlock()
+	for name := range ff.values {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return
+}
+
+// AddListener registers a listener that is notified of each flag that changes.
+// The returned function removes the listener.
+func (ff *FeatureFlags) AddListener(l FeatureFlagListener) (cancel func()) {
+	return ff.listeners.add(l)
+}
+
+// OnEvent is a KVListener that updates these flags. Errors are ignored, leaving
+// the current values in place until consul can be reached again.
+func (ff *FeatureFlags) OnEvent(e KVWatchEvent) {
+	if e.Err != nil {
+		return
+	}
+
+	next := make(map[string]string, len(e.Pairs))
+	for _, pair := range e.Pairs {
+		name := strings.TrimPrefix(pair.Key, ff.prefix)
+		if len(name) > 0 && !strings.HasSuffix(name, "/") {
+			next[name] = strings.TrimSpace(string(pair.Value))
+		}
+	}
+
+	var changes []FeatureFlagChange
+	ff.lock.Lock()
+	for name, value := range next {
+		if previous, exists := ff.values[name]; !exists || previous != value {
+			changes = append(changes, FeatureFlagChange{
+				Name:     name,
+				Value:    value,
+				Previous: previous,
+			})
+		}
+	}
+
+	for name, previous := range ff.values {
+		if _, exists := next[name]; !exists {
+			changes = append(changes, FeatureFlagChange{
+				Name:     name,
+				Previous: previous,
+				Deleted:  true,
+			})
+		}
+	}
+
+	ff.values = next
+	ff.lock.Unlock()
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Name < changes[j].Name })
+	for _, change := range changes {
+		ff.listeners.visit(func(l FeatureFlagListener) {
+			l(change)
+		})
+	}
+}
+
+// ProvideFeatureFlags emits a *FeatureFlags that is kept current by watching the
+// configured prefix for the lifetime of the application. The flags are first loaded
+// when the application starts.
+//
+// This provider requires an *api.KV, such as the one emitted by Provide.
+func ProvideFeatureFlags(cfg FeatureFlagsConfig) fx.Option {
+	return fx.Provide(
+		func(kv *api.KV, l fx.Lifecycle) *FeatureFlags {
+			ff := NewFeatureFlags(cfg.Prefix)
+			w := NewKVWatcher(kv, KVWatch{
+				Key:           cfg.Prefix,
+				Prefix:        true,
+				RetryInterval: cfg.RetryInterval,
+				Options:       cfg.Options,
+			})
+
+			w.AddListener(ff.OnEvent)
+			l.Append(fx.StartStopHook(w.Start, w.Stop))
+			return ff
+		},
+	)
+}
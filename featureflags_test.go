@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/praetor/praetortest"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+type FeatureFlagsSuite struct {
+	suite.Suite
+}
+
+func (suite *FeatureFlagsSuite) TestGetters() {
+	ff := NewFeatureFlags("flags/")
+	ff.OnEvent(KVWatchEvent{
+		Pairs: api.KVPairs{
+			{Key: "flags/"},
+			{Key: "flags/enabled", Value: []byte("true\n")},
+			{Key: "flags/mode", Value: []byte(" fast ")},
+			{Key: "flags/nested/", Value: nil},
+		},
+	})
+
+	suite.Equal([]string{"enabled", "mode"}, ff.Names())
+
+	value, exists := ff.Lookup("mode")
+	suite.True(exists)
+	suite.Equal("fast", value)
+
+	_, exists = ff.Lookup("missing")
+	suite.False(exists)
+
+	suite.True(ff.Bool("enabled", false))
+	suite.True(ff.Bool("missing", true))
+	suite.False(ff.Bool("mode", false))
+
+	suite.Equal("fast", ff.String("mode", "slow"))
+	suite.Equal("slow", ff.String("missing", "slow"))
+}
+
+func (suite *FeatureFlagsSuite) TestOnEvent() {
+	var (
+		ff      = NewFeatureFlags("flags/")
+		changes []FeatureFlagChange
+	)
+
+	ff.AddListener(func(c FeatureFlagChange) {
+		changes = append(changes, c)
+	})
+
+	ff.OnEvent(KVWatchEvent{
+		Pairs: api.KVPairs{
+			{Key: "flags/a", Value: []byte("1")},
+			{Key: "flags/b", Value: []byte("2")},
+		},
+	})
+
+	suite.Equal(
+		[]FeatureFlagChange{
+			{Name: "a", Value: "1"},
+			{Name: "b", Value: "2"},
+		},
+		changes,
+	)
+
+	changes = nil
+	ff.OnEvent(KVWatchEvent{Err: errors.New("expected")})
+	suite.Empty(changes)
+	suite.Equal("1", ff.String("a", ""))
+
+	ff.OnEvent(KVWatchEvent{
+		Pairs: api.KVPairs{
+			{Key: "flags/a", Value: []byte("1")},
+			{Key: "flags/b", Value: []byte("3")},
+			{Key: "flags/c", Value: []byte("4")},
+		},
+	})
+
+	suite.Equal(
+		[]FeatureFlagChange{
+			{Name: "b", Value: "3", Previous: "2"},
+			{Name: "c", Value: "4"},
+		},
+		changes,
+	)
+
+	changes = nil
+	ff.OnEvent(KVWatchEvent{
+		Pairs: api.KVPairs{
+			{Key: "flags/c", Value: []byte("4")},
+		},
+	})
+
+	suite.Equal(
+		[]FeatureFlagChange{
+			{Name: "a", Previous: "1", Deleted: true},
+			{Name: "b", Previous: "3", Deleted: true},
+		},
+		changes,
+	)
+}
+
+func (suite *FeatureFlagsSuite) TestProvideFeatureFlags() {
+	consul := praetortest.NewServer(suite.T())
+	consul.SetKV("flags/myapp/enabled", []byte("true"))
+
+	var ff *FeatureFlags
+	app := fxtest.New(
+		suite.T(),
+		fx.Supply(consul.Client(suite.T()).KV()),
+		ProvideFeatureFlags(FeatureFlagsConfig{
+			Prefix: "flags/myapp/",
+			Options: api.QueryOptions{
+				WaitTime: time.Second,
+			},
+		}),
+		fx.Populate(&ff),
+	)
+
+	suite.Require().NotNil(ff)
+	suite.False(ff.Bool("enabled", false))
+
+	app.RequireStart()
+	defer app.RequireStop()
+	suite.True(ff.Bool("enabled", false))
+
+	changes := make(chan FeatureFlagChange, 10)
+	ff.AddListener(func(c FeatureFlagChange) {
+		changes <- c
+	})
+
+	consul.SetKV("flags/myapp/enabled", []byte("false"))
+	select {
+	case c := <-changes:
+		suite.Equal(FeatureFlagChange{Name: "enabled", Value: "false", Previous: "true"}, c)
+
+	case <-time.After(5 * time.Second):
+		suite.FailNow("no feature flag change")
+	}
+
+	suite.False(ff.Bool("enabled", true))
+}
+
+func TestFeatureFlags(t *testing.T) {
+	suite.Run(t, new(FeatureFlagsSuite))
+}
@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"go.uber.org/fx"
+)
+
+// DefaultIntentionCacheTTL is the time an IntentionChecker caches a result
+// when no CacheTTL is configured.
+const DefaultIntentionCacheTTL = 10 * time.Second
+
+// IntentionCheckerConfig describes the source service whose outbound connect
+// connections are checked by an IntentionChecker.
+type IntentionCheckerConfig struct {
+	// Source is the name of the service making connections, typically the service
+	// this application registers. This field is required.
+	Source string `json:"source" yaml:"source" mapstructure:"source"`
+
+	// CacheTTL is the time a result is cached. If unset, DefaultIntentionCacheTTL is used.
+	CacheTTL time.Duration `json:"cacheTTL" yaml:"cacheTTL" mapstructure:"cacheTTL"`
+
+	// Options are the base query options used for each check, e.g. for datacenter
+	// or token. The context is managed by the checker.
+	Options api.QueryOptions `json:"-" yaml:"-" mapstructure:"-"`
+}
+
+// intentionResult is a cached intention check.
+type intentionResult struct {
+	allowed bool
+	expires time.Time
+}
+
+// intentionCall is an intention check in progress. Concurrent callers asking about
+// the same destination wait for this call rather than querying consul themselves.
+type intentionCall struct {
+	done    chan struct{}
+	allowed bool
+	err     error
+
+	// abandoned is set when the caller making this check gave up on it, in which
+	// case its error says nothing about the callers waiting on it.
+	abandoned bool
+}
+
+// IntentionChecker evaluates consul connect intentions, so that an application can
+// learn whether the mesh will authorize a connection before dialing it. Results are
+// cached briefly, as intentions change rarely compared to how often connections are made.
+// Concurrent cache misses for the same destination result in a single query.
+type IntentionChecker struct {
+	connect *api.Connect
+	cfg     IntentionCheckerConfig
+
+	lock  sync.Mutex
+	cache map[string]intentionResult
+	calls map[string]*intentionCall
+}
+
+// NewIntentionChecker creates an IntentionChecker for the configured source service.
+func NewIntentionChecker(connect *api.Connect, cfg IntentionCheckerConfig) *IntentionChecker {
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = DefaultIntentionCacheTTL
+	}
+
+	return &IntentionChecker{
+		connect: connect,
+		cfg:     cfg,
+		cache:   make(map[string]intentionResult),
+		calls:   make(map[string]*intentionCall),
+	}
+}
+
+// Allowed tests if connections from the source service to the given destination
+// service are authorized. Errors from consul are returned and are not cached.
+func (ic *IntentionChecker) Allowed(ctx context.Context, destination string) (bool, error) {
+	for {
+		now := time.Now()
+		ic.lock.Lock()
+		if result, ok := ic.cache[destination]; ok && now.Before(result.expires) {
+			ic.lock.Unlock()
+			return result.allowed, nil
+		}
+
+		call, inFlight := ic.calls[destination]
+		if !inFlight {
+			call = &intentionCall{done: make(chan struct{})}
+			ic.calls[destination] = call
+		}
+
+		ic.lock.Unlock()
+		if !inFlight {
+			ic.check(ctx, destination, call, now)
+			return call.allowed, call.err
+		}
+
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+
+		if !call.abandoned {
+			return call.allowed, call.err
+		}
+	}
+}
+
+// check queries consul on behalf of a call, caches a successful result, and
+// releases any callers waiting on the call.
+func (ic *IntentionChecker) check(ctx context.Context, destination string, call *intentionCall, now time.Time) {
+	q := ic.cfg.Options
+	call.allowed, _, call.err = ic.connect.IntentionCheck(
+		&api.IntentionCheck{
+			Source:      ic.cfg.Source,
+			Destination: destination,
+			SourceType:  api.IntentionSourceConsul,
+		},
+		q.WithContext(ctx),
+	)
+
+	call.abandoned = call.err != nil && ctx.Err() != nil
+
+	ic.lock.Lock()
+	delete(ic.calls, destination)
+	if call.err == nil {
+		ic.prune(now)
+		ic.cache[destination] = intentionResult{
+			allowed: call.allowed,
+			expires: now.Add(ic.cfg.CacheTTL),
+		}
+	}
+
+	ic.lock.Unlock()
+	close(call.done)
+}
+
+// prune removes expired results from the cache, which would otherwise grow with
+// every destination ever checked. The lock must be held.
+func (ic *IntentionChecker) prune(now time.Time) {
+	for destination, result := range ic.cache {
+		if !now.Before(result.expires) {
+			delete(ic.cache, destination)
+		}
+	}
+}
+
+// ProvideIntentionChecker emits an *IntentionChecker for the given configuration.
+//
+// This provider requires an *api.Client, such as the one emitted by Provide.
+func ProvideIntentionChecker(cfg IntentionCheckerConfig) fx.Option {
+	return fx.Provide(
+		func(client *api.Client) *IntentionChecker {
+			return NewIntentionChecker(client.Connect(), cfg)
+		},
+	)
+}
@@ -0,0 +1,215 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/praetor/praetortest"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+type IntentionCheckerSuite struct {
+	suite.Suite
+
+	consul *praetortest.Server
+	client *api.Client
+}
+
+func (suite *IntentionCheckerSuite) SetupTest() {
+	suite.consul = praetortest.NewServer(suite.T())
+	suite.client = suite.consul.Client(suite.T())
+}
+
+func (suite *IntentionCheckerSuite) allowed(ic *IntentionChecker, destination string) bool {
+	allowed, err := ic.Allowed(context.Background(), destination)
+	suite.Require().NoError(err)
+	return allowed
+}
+
+func (suite *IntentionCheckerSuite) TestDefaults() {
+	ic := NewIntentionChecker(suite.client.Connect(), IntentionCheckerConfig{Source: "web"})
+	suite.Equal(DefaultIntentionCacheTTL, ic.cfg.CacheTTL)
+}
+
+func (suite *IntentionCheckerSuite) TestAllowed() {
+	suite.consul.SetIntention("web", "db", false)
+	ic := NewIntentionChecker(suite.client.Connect(), IntentionCheckerConfig{
+		Source:   "web",
+		CacheTTL: 50 * time.Millisecond,
+	})
+
+	suite.False(suite.allowed(ic, "db"))
+	suite.True(suite.allowed(ic, "cache"))
+	suite.Equal(2, suite.consul.IntentionChecks())
+
+	// cached results don't query consul
+	suite.consul.SetIntention("web", "db", true)
+	suite.False(suite.allowed(ic, "db"))
+	suite.Equal(2, suite.consul.IntentionChecks())
+
+	time.Sleep(100 * time.Millisecond)
+	suite.True(suite.allowed(ic, "db"))
+	suite.Equal(3, suite.consul.IntentionChecks())
+}
+
+func (suite *IntentionCheckerSuite) TestPrune() {
+	ic := NewIntentionChecker(suite.client.Connect(), IntentionCheckerConfig{
+		Source:   "web",
+		CacheTTL: 20 * time.Millisecond,
+	})
+
+	suite.True(suite.allowed(ic, "db"))
+	suite.True(suite.allowed(ic, "cache"))
+	suite.Len(ic.cache, 2)
+
+	// expired results are removed when the next result is cached
+	time.Sleep(50 * time.Millisecond)
+	suite.True(suite.allowed(ic, "queue"))
+	suite.Len(ic.cache, 1)
+	suite.Contains(ic.cache, "queue")
+
+	// an expired destination is refreshed from consul
+	suite.True(suite.allowed(ic, "db"))
+	suite.Equal(4, suite.consul.IntentionChecks())
+	suite.Len(ic.cache, 2)
+}
+
+// blockingIntentions starts a server that counts intention checks and holds each
+// one until release is closed or the request is canceled.
+func (suite *IntentionCheckerSuite) blockingIntentions(checks *atomic.Int32, release <-chan struct{}) *api.Connect {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checks.Add(1)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"Allowed": true}`))
+	}))
+
+	suite.T().Cleanup(server.Close)
+	client, err := api.NewClient(&api.Config{Address: server.Listener.Addr().String()})
+	suite.Require().NoError(err)
+	return client.Connect()
+}
+
+func (suite *IntentionCheckerSuite) TestConcurrentMisses() {
+	var (
+		checks  atomic.Int32
+		release = make(chan struct{})
+		ic      = NewIntentionChecker(suite.blockingIntentions(&checks, release), IntentionCheckerConfig{Source: "web"})
+
+		wg      sync.WaitGroup
+		results = make(chan bool, 10)
+	)
+
+	for i := 0; i < cap(results); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			allowed, err := ic.Allowed(context.Background(), "db")
+			suite.NoError(err)
+			results <- allowed
+		}()
+	}
+
+	suite.Eventually(
+		func() bool { return checks.Load() > 0 },
+		5*time.Second,
+		time.Millisecond,
+	)
+
+	// give the other callers time to find the call in progress
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(results)
+
+	suite.Equal(int32(1), checks.Load())
+	for allowed := range results {
+		suite.True(allowed)
+	}
+}
+
+func (suite *IntentionCheckerSuite) TestAbandonedCall() {
+	var (
+		checks  atomic.Int32
+		release = make(chan struct{})
+		ic      = NewIntentionChecker(suite.blockingIntentions(&checks, release), IntentionCheckerConfig{Source: "web"})
+
+		ctx, cancel = context.WithCancel(context.Background())
+		first       = make(chan error, 1)
+		second      = make(chan bool, 1)
+	)
+
+	go func() {
+		_, err := ic.Allowed(ctx, "db")
+		first <- err
+	}()
+
+	suite.Eventually(
+		func() bool { return checks.Load() == 1 },
+		5*time.Second,
+		time.Millisecond,
+	)
+
+	go func() {
+		allowed, err := ic.Allowed(context.Background(), "db")
+		suite.NoError(err)
+		second <- allowed
+	}()
+
+	// the waiting caller makes its own check when the first caller gives up
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	suite.ErrorIs(<-first, context.Canceled)
+
+	suite.Eventually(
+		func() bool { return checks.Load() == 2 },
+		5*time.Second,
+		time.Millisecond,
+	)
+
+	close(release)
+	suite.True(<-second)
+}
+
+func (suite *IntentionCheckerSuite) TestError() {
+	suite.consul.Close()
+	ic := NewIntentionChecker(suite.client.Connect(), IntentionCheckerConfig{Source: "web"})
+	allowed, err := ic.Allowed(context.Background(), "db")
+	suite.Error(err)
+	suite.False(allowed)
+}
+
+func (suite *IntentionCheckerSuite) TestProvideIntentionChecker() {
+	var ic *IntentionChecker
+	app := fxtest.New(
+		suite.T(),
+		fx.Supply(suite.client),
+		ProvideIntentionChecker(IntentionCheckerConfig{Source: "web"}),
+		fx.Populate(&ic),
+	)
+
+	suite.Require().NotNil(ic)
+	app.RequireStart()
+	suite.True(suite.allowed(ic, "db"))
+	app.RequireStop()
+}
+
+func TestIntentionChecker(t *testing.T) {
+	suite.Run(t, new(IntentionCheckerSuite))
+}
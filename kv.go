@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/consul/api"
+)
+
+// ErrKeyNotFound indicates that a consul KV key did not exist.
+var ErrKeyNotFound = errors.New("key not found")
+
+// ErrTooManyConflicts indicates that UpdateJSON gave up because other writers
+// kept modifying a key between its read and its check-and-set.
+var ErrTooManyConflicts = errors.New("too many concurrent modifications")
+
+// KVEntry is a decoded consul KV value along with the metadata needed
+// for check-and-set operations.
+type KVEntry[T any] struct {
+	// Key is the full consul key of this entry.
+	Key string
+
+	// Value is the decoded value.
+	Value T
+
+	// ModifyIndex is the consul index of the last modification to this key.
+	// This value can be passed to CASJSON to perform a check-and-set.
+	ModifyIndex uint64
+
+	// Flags are the opaque, user-defined flags stored with the key.
+	Flags uint64
+}
+
+// KVStore wraps a consul *api.KV. The generic functions in this package,
+// such as GetJSON and PutJSON, use a KVStore to avoid repeating the
+// marshal/unmarshal boilerplate around api.KVPair.
+type KVStore struct {
+	kv *api.KV
+}
+
+// NewKVStore creates a KVStore that uses the given consul KV client.
+func NewKVStore(kv *api.KV) *KVStore {
+	return &KVStore{
+		kv: kv,
+	}
+}
+
+// KV returns the underlying consul KV client.
+func (s *KVStore) KV() *api.KV {
+	return s.kv
+}
+
+// DeleteCAS deletes a key only if its ModifyIndex still matches the given index.
+// The returned bool indicates whether the delete happened.
+func (s *KVStore) DeleteCAS(key string, modifyIndex uint64, w *api.WriteOptions) (bool, error) {
+	deleted, _, err := s.kv.DeleteCAS(
+		&api.KVPair{
+			Key:         key,
+			ModifyIndex: modifyIndex,
+		},
+		w,
+	)
+
+	return deleted, err
+}
+
+// decodeEntry unmarshals a KVPair's value as JSON.
+func decodeEntry[T any](pair *api.KVPair) (e KVEntry[T], err error) {
+	e.Key = pair.Key
+	e.ModifyIndex = pair.ModifyIndex
+	e.Flags = pair.Flags
+	err = json.Unmarshal(pair.Value, &e.Value)
+	return
+}
+
+// GetJSON fetches a key and unmarshals its value as JSON. If the key does not
+// exist, this function returns ErrKeyNotFound.
+func GetJSON[T any](s *KVStore, key string, q *api.QueryOptions) (KVEntry[T], error) {
+	pair, _, err := s.kv.Get(key, q)
+	switch {
+	case err != nil:
+		return KVEntry[T]{}, err
+
+	case pair == nil:
+		return KVEntry[T]{}, ErrKeyNotFound
+
+	default:
+		return decodeEntry[T](pair)
+	}
+}
+
+// PutJSON marshals a value as JSON and unconditionally writes it to the given key.
+func PutJSON[T any](s *KVStore, key string, v T, w *api.WriteOptions) error {
+	data, err := json.Marshal(v)
+	if err == nil {
+		_, err = s.kv.Put(
+			&api.KVPair{
+				Key:   key,
+				Value: data,
+			},
+			w,
+		)
+	}
+
+	return err
+}
+
+// CASJSON marshals a value as JSON and writes it to the given key only if the
+// key's ModifyIndex still matches the given index. A modifyIndex of zero means
+// the key is only written if it does not already exist.
+//
+// The returned bool indicates whether the write happened. A false with a nil
+// error means another writer modified the key first.
+func CASJSON[T any](s *KVStore, key string, v T, modifyIndex uint64, w *api.WriteOptions) (bool, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return false, err
+	}
+
+	written, _, err := s.kv.CAS(
+		&api.KVPair{
+			Key:         key,
+			Value:       data,
+			ModifyIndex: modifyIndex,
+		},
+		w,
+	)
+
+	return written, err
+}
+
+// UpdateJSON performs a read-modify-write of a key using check-and-set. The
+// update function is passed the current value, or the zero value if the key
+// does not exist, and returns the new value. If another writer modifies the
+// key concurrently, the read and update are retried up to maxAttempts times.
+// A maxAttempts less than one means a single attempt.
+//
+// The read uses the datacenter, token, namespace, partition, and context of w,
+// so that the check-and-set applies to the same key that was read. If the
+// attempts run out, the returned error wraps ErrTooManyConflicts. If the update
+// function returns an error, that error is returned and nothing is written.
+func UpdateJSON[T any](s *KVStore, key string, maxAttempts int, update func(T) (T, error), w *api.WriteOptions) (updated T, err error) {
+	q := readOptions(w)
+	for attempt := 0; attempt < max(maxAttempts, 1); attempt++ {
+		var current KVEntry[T]
+		current, err = GetJSON[T](s, key, q)
+		if err != nil && !errors.Is(err, ErrKeyNotFound) {
+			return
+		}
+
+		updated, err = update(current.Value)
+		if err != nil {
+			return
+		}
+
+		var written bool
+		written, err = CASJSON(s, key, updated, current.ModifyIndex, w)
+		if err != nil || written {
+			return
+		}
+	}
+
+	err = fmt.Errorf("%w: unable to update key [%s]", ErrTooManyConflicts, key)
+	return
+}
+
+// readOptions returns the QueryOptions that read from the same place the given
+// WriteOptions write to.
+func readOptions(w *api.WriteOptions) *api.QueryOptions {
+	if w == nil {
+		return nil
+	}
+
+	q := &api.QueryOptions{
+		Datacenter: w.Datacenter,
+		Token:      w.Token,
+		Namespace:  w.Namespace,
+		Partition:  w.Partition,
+	}
+
+	return q.WithContext(w.Context())
+}
+
+// ListJSON fetches every key under the given prefix and unmarshals each value
+// as JSON. The returned map is keyed by each key with the prefix removed.
+// Keys that have no value, such as "folder" keys ending in '/', are skipped.
+func ListJSON[T any](s *KVStore, prefix string, q *api.QueryOptions) (map[string]KVEntry[T], error) {
+	pairs, _, err := s.kv.List(prefix, q)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string]KVEntry[T], len(pairs))
+	for _, pair := range pairs {
+		if len(pair.Value) == 0 && strings.HasSuffix(pair.Key, "/") {
+			continue
+		}
+
+		e, err := decodeEntry[T](pair)
+		if err != nil {
+			return nil, err
+		}
+
+		entries[strings.TrimPrefix(pair.Key, prefix)] = e
+	}
+
+	return entries, nil
+}
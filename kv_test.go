@@ -0,0 +1,215 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/praetor/praetortest"
+)
+
+type testValue struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+type KVSuite struct {
+	suite.Suite
+
+	consul *praetortest.Server
+	store  *KVStore
+}
+
+func (suite *KVSuite) SetupTest() {
+	suite.consul = praetortest.NewServer(suite.T())
+	suite.store = NewKVStore(suite.consul.Client(suite.T()).KV())
+}
+
+func (suite *KVSuite) TestKV() {
+	suite.NotNil(suite.store.KV())
+}
+
+func (suite *KVSuite) TestGetJSON() {
+	suite.Run("NotFound", func() {
+		_, err := GetJSON[testValue](suite.store, "missing", nil)
+		suite.ErrorIs(err, ErrKeyNotFound)
+	})
+
+	suite.Run("Found", func() {
+		suite.consul.SetKV("found", []byte(`{"name": "test", "count": 12}`))
+		e, err := GetJSON[testValue](suite.store, "found", nil)
+		suite.Require().NoError(err)
+		suite.Equal("found", e.Key)
+		suite.Equal(testValue{Name: "test", Count: 12}, e.Value)
+		suite.NotZero(e.ModifyIndex)
+	})
+
+	suite.Run("BadJSON", func() {
+		suite.consul.SetKV("bad", []byte(`{`))
+		_, err := GetJSON[testValue](suite.store, "bad", nil)
+		suite.Error(err)
+	})
+}
+
+func (suite *KVSuite) TestPutJSON() {
+	suite.Require().NoError(
+		PutJSON(suite.store, "put", testValue{Name: "put", Count: 1}, nil),
+	)
+
+	e, err := GetJSON[testValue](suite.store, "put", nil)
+	suite.Require().NoError(err)
+	suite.Equal(testValue{Name: "put", Count: 1}, e.Value)
+}
+
+func (suite *KVSuite) TestCASJSON() {
+	written, err := CASJSON(suite.store, "cas", testValue{Count: 1}, 0, nil)
+	suite.Require().NoError(err)
+	suite.True(written)
+
+	// a zero index only writes if the key doesn't exist
+	written, err = CASJSON(suite.store, "cas", testValue{Count: 2}, 0, nil)
+	suite.Require().NoError(err)
+	suite.False(written)
+
+	e, err := GetJSON[testValue](suite.store, "cas", nil)
+	suite.Require().NoError(err)
+	suite.Equal(1, e.Value.Count)
+
+	written, err = CASJSON(suite.store, "cas", testValue{Count: 3}, e.ModifyIndex, nil)
+	suite.Require().NoError(err)
+	suite.True(written)
+
+	written, err = CASJSON(suite.store, "cas", testValue{Count: 4}, e.ModifyIndex, nil)
+	suite.Require().NoError(err)
+	suite.False(written)
+}
+
+func (suite *KVSuite) TestUpdateJSON() {
+	suite.Run("Success", func() {
+		for i := 1; i <= 3; i++ {
+			updated, err := UpdateJSON(suite.store, "counter", 1, func(v testValue) (testValue, error) {
+				v.Count++
+				return v, nil
+			}, nil)
+
+			suite.Require().NoError(err)
+			suite.Equal(i, updated.Count)
+		}
+	})
+
+	suite.Run("UpdateError", func() {
+		expectedErr := errors.New("expected")
+		_, err := UpdateJSON(suite.store, "counter", 1, func(v testValue) (testValue, error) {
+			return v, expectedErr
+		}, nil)
+
+		suite.ErrorIs(err, expectedErr)
+	})
+
+	suite.Run("Contention", func() {
+		_, err := UpdateJSON(suite.store, "contended", 2, func(v testValue) (testValue, error) {
+			// simulate another writer getting in first
+			suite.consul.SetKV("contended", []byte(`{"count": 100}`))
+			return v, nil
+		}, nil)
+
+		suite.ErrorIs(err, ErrTooManyConflicts)
+	})
+
+	suite.Run("WriteOptions", func() {
+		type request struct {
+			method, dc, token, ns, partition string
+		}
+
+		var (
+			requests []request
+			server   = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				requests = append(requests, request{
+					method:    r.Method,
+					dc:        r.URL.Query().Get("dc"),
+					token:     r.Header.Get("X-Consul-Token"),
+					ns:        r.URL.Query().Get("ns"),
+					partition: r.URL.Query().Get("partition"),
+				})
+
+				rw.Header().Set("Content-Type", "application/json")
+				if r.Method == http.MethodGet {
+					rw.Write([]byte(`[{"Key": "remote", "Value": "eyJjb3VudCI6IDF9", "ModifyIndex": 7}]`))
+				} else {
+					rw.Write([]byte(`true`))
+				}
+			}))
+		)
+
+		defer server.Close()
+		client, err := api.NewClient(&api.Config{Address: server.Listener.Addr().String()})
+		suite.Require().NoError(err)
+
+		updated, err := UpdateJSON(NewKVStore(client.KV()), "remote", 1, func(v testValue) (testValue, error) {
+			v.Count++
+			return v, nil
+		}, &api.WriteOptions{Datacenter: "dc2", Token: "secret", Namespace: "ns1", Partition: "part1"})
+
+		suite.Require().NoError(err)
+		suite.Equal(2, updated.Count)
+		suite.Equal(
+			[]request{
+				{method: http.MethodGet, dc: "dc2", token: "secret", ns: "ns1", partition: "part1"},
+				{method: http.MethodPut, dc: "dc2", token: "secret", ns: "ns1", partition: "part1"},
+			},
+			requests,
+		)
+	})
+}
+
+func (suite *KVSuite) TestDeleteCAS() {
+	suite.Require().NoError(PutJSON(suite.store, "delete", testValue{}, nil))
+	e, err := GetJSON[testValue](suite.store, "delete", nil)
+	suite.Require().NoError(err)
+
+	deleted, err := suite.store.DeleteCAS("delete", e.ModifyIndex+1, nil)
+	suite.Require().NoError(err)
+	suite.False(deleted)
+
+	deleted, err = suite.store.DeleteCAS("delete", e.ModifyIndex, nil)
+	suite.Require().NoError(err)
+	suite.True(deleted)
+	suite.Nil(suite.consul.KV("delete"))
+}
+
+func (suite *KVSuite) TestListJSON() {
+	suite.Run("Empty", func() {
+		entries, err := ListJSON[testValue](suite.store, "empty/", nil)
+		suite.Require().NoError(err)
+		suite.Empty(entries)
+	})
+
+	suite.Run("Values", func() {
+		suite.consul.SetKV("list/", nil)
+		suite.consul.SetKV("list/a", []byte(`{"name": "a"}`))
+		suite.consul.SetKV("list/b", []byte(`{"name": "b"}`))
+
+		entries, err := ListJSON[testValue](suite.store, "list/", nil)
+		suite.Require().NoError(err)
+		suite.Len(entries, 2)
+		suite.Equal("a", entries["a"].Value.Name)
+		suite.Equal("list/a", entries["a"].Key)
+		suite.Equal("b", entries["b"].Value.Name)
+	})
+
+	suite.Run("BadJSON", func() {
+		suite.consul.SetKV("badlist/a", []byte(`{`))
+		_, err := ListJSON[testValue](suite.store, "badlist/", nil)
+		suite.Error(err)
+	})
+}
+
+func TestKV(t *testing.T) {
+	suite.Run(t, new(KVSuite))
+}
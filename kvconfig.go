@@ -0,0 +1,217 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"go.uber.org/fx"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// FormatJSON indicates a KV document is JSON.
+	FormatJSON = "json"
+
+	// FormatYAML indicates a KV document is YAML.
+	FormatYAML = "yaml"
+)
+
+// KVConfigSource describes a configuration document stored under a consul key.
+type KVConfigSource struct {
+	// Key is the consul key that holds the configuration document.
+	Key string `json:"key" yaml:"key" mapstructure:"key"`
+
+	// Format is the document format, either FormatJSON or FormatYAML. If unset,
+	// the format is inferred from the key's extension: keys ending in .yaml or .yml
+	// are YAML, and all other keys are JSON.
+	Format string `json:"format" yaml:"format" mapstructure:"format"`
+
+	// Watch enables hot reloading. When true, the key is watched while the
+	// application is running and listeners are notified of each new version.
+	Watch bool `json:"watch" yaml:"watch" mapstructure:"watch"`
+
+	// RetryInterval is the time to wait before retrying a failed watch query.
+	// This field is only used when Watch is true.
+	RetryInterval time.Duration `json:"retryInterval" yaml:"retryInterval" mapstructure:"retryInterval"`
+
+	// Options are the query options used to read the key.
+	Options api.QueryOptions `json:"-" yaml:"-" mapstructure:"-"`
+}
+
+// decoder returns the function used to unmarshal this source's document.
+func (src KVConfigSource) decoder() (func([]byte, any) error, error) {
+	format := strings.ToLower(src.Format)
+	if len(format) == 0 {
+		switch path.Ext(src.Key) {
+		case ".yaml", ".yml":
+			format = FormatYAML
+		default:
+			format = FormatJSON
+		}
+	}
+
+	switch format {
+	case FormatJSON:
+		return json.Unmarshal, nil
+
+	case FormatYAML:
+		return yaml.Unmarshal, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported KV config format [%s]", src.Format)
+	}
+}
+
+// KVConfig holds the current version of a configuration struct decoded from
+// a consul key.
+type KVConfig[T any] struct {
+	key       string
+	decode    func([]byte, any) error
+	listeners listeners[func(T)]
+
+	lock        sync.Mutex
+	current     T
+	modifyIndex uint64
+	err         error
+}
+
+// NewKVConfig reads and decodes the configuration document described by src.
+// The key must exist. The given context bounds the read.
+//
+// The returned KVConfig does not watch for changes on its own. See ProvideKVConfig.
+func NewKVConfig[T any](ctx context.Context, kv *api.KV, src KVConfigSource) (*KVConfig[T], error) {
+	decode, err := src.decoder()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &KVConfig[T]{
+		key:    src.Key,
+		decode: decode,
+	}
+
+	q := src.Options
+	pair, _, err := kv.Get(src.Key, q.WithContext(ctx))
+	switch {
+	case err != nil:
+		return nil, err
+
+	case pair == nil:
+		return nil, fmt.Errorf("configuration key [%s]: %w", src.Key, ErrKeyNotFound)
+
+	default:
+		err = decode(pair.Value, &c.current)
+		c.modifyIndex = pair.ModifyIndex
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("configuration key [%s]: %w", src.Key, err)
+	}
+
+	return c, nil
+}
+
+// Get returns the most recently decoded configuration.
+func (c *KVConfig[T]) Get() T {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.current
+}
+
+// Err returns the error from the most recent reload, or nil if that
+// reload succeeded. When a reload fails, Get continues to return the last
+// successfully decoded configuration.
+func (c *KVConfig[T]) Err() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.err
+}
+
+// AddListener registers a callback that receives each newly decoded version
+// of the configuration. The returned function removes the listener.
+func (c *KVConfig[T]) AddListener(l func(T)) (cancel func()) {
+	return c.listeners.add(l)
+}
+
+// OnEvent is a KVListener that updates this configuration. A KVConfig can be
+// attached to any KVWatcher for its key.
+func (c *KVConfig[T]) OnEvent(e KVWatchEvent) {
+	var (
+		next    T
+		changed bool
+	)
+
+	c.lock.Lock()
+	switch {
+	case e.Err != nil:
+		c.err = e.Err
+
+	case len(e.Pairs) == 0:
+		c.err = fmt.Errorf("configuration key [%s]: %w", c.key, ErrKeyNotFound)
+
+	case e.Pairs[0].ModifyIndex == c.modifyIndex:
+		// nothing changed, e.g. the watcher's initial query or a recovery from an outage,
+		// so the current configuration is still valid
+		c.err = nil
+
+	default:
+		if err := c.decode(e.Pairs[0].Value, &next); err != nil {
+			c.err = fmt.Errorf("configuration key [%s]: %w", c.key, err)
+		} else {
+			c.current, c.modifyIndex, c.err = next, e.Pairs[0].ModifyIndex, nil
+			changed = true
+		}
+	}
+
+	c.lock.Unlock()
+	if changed {
+		c.listeners.visit(func(l func(T)) {
+			l(next)
+		})
+	}
+}
+
+// ProvideKVConfig loads a configuration document from consul and emits both the
+// decoded T and a *KVConfig[T]. The T component is the configuration as it was at
+// startup. Code that wants hot reloading should depend on *KVConfig[T] instead.
+//
+// Since T is emitted as a component, the document is read while the application
+// is being constructed rather than when it starts. That read is bounded by
+// fx.DefaultTimeout. If src.Watch is set, the key is watched for the lifetime
+// of the application.
+//
+// This provider requires an *api.KV, such as the one emitted by Provide.
+func ProvideKVConfig[T any](src KVConfigSource) fx.Option {
+	return fx.Provide(
+		func(kv *api.KV, l fx.Lifecycle) (*KVConfig[T], error) {
+			ctx, cancel := context.WithTimeout(context.Background(), fx.DefaultTimeout)
+			defer cancel()
+
+			c, err := NewKVConfig[T](ctx, kv, src)
+			if err == nil && src.Watch {
+				w := NewKVWatcher(kv, KVWatch{
+					Key:           src.Key,
+					RetryInterval: src.RetryInterval,
+					Options:       src.Options,
+				})
+
+				w.AddListener(c.OnEvent)
+				l.Append(fx.StartStopHook(w.Start, w.Stop))
+			}
+
+			return c, err
+		},
+		func(c *KVConfig[T]) T {
+			return c.Get()
+		},
+	)
+}
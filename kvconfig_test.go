@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/praetor/praetortest"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+type KVConfigSuite struct {
+	suite.Suite
+
+	consul *praetortest.Server
+	kv     *api.KV
+}
+
+func (suite *KVConfigSuite) SetupTest() {
+	suite.consul = praetortest.NewServer(suite.T())
+	suite.kv = suite.consul.Client(suite.T()).KV()
+}
+
+func (suite *KVConfigSuite) TestNewKVConfig() {
+	suite.consul.SetKV("config.json", []byte(`{"name": "json", "count": 1}`))
+	suite.consul.SetKV("config.yaml", []byte("name: yaml\ncount: 2\n"))
+	suite.consul.SetKV("config.yml", []byte("name: yml\ncount: 3\n"))
+	suite.consul.SetKV("config", []byte("name: explicit\ncount: 4\n"))
+	suite.consul.SetKV("bad.json", []byte(`{`))
+
+	testCases := []struct {
+		src         KVConfigSource
+		expected    testValue
+		expectedErr bool
+	}{
+		{
+			src:      KVConfigSource{Key: "config.json"},
+			expected: testValue{Name: "json", Count: 1},
+		},
+		{
+			src:      KVConfigSource{Key: "config.yaml"},
+			expected: testValue{Name: "yaml", Count: 2},
+		},
+		{
+			src:      KVConfigSource{Key: "config.yml"},
+			expected: testValue{Name: "yml", Count: 3},
+		},
+		{
+			src:      KVConfigSource{Key: "config", Format: "YAML"},
+			expected: testValue{Name: "explicit", Count: 4},
+		},
+		{
+			src:         KVConfigSource{Key: "config.json", Format: "xml"},
+			expectedErr: true,
+		},
+		{
+			src:         KVConfigSource{Key: "bad.json"},
+			expectedErr: true,
+		},
+		{
+			src:         KVConfigSource{Key: "missing.json"},
+			expectedErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(testCase.src.Key, func() {
+			c, err := NewKVConfig[testValue](context.Background(), suite.kv, testCase.src)
+			if testCase.expectedErr {
+				suite.Error(err)
+				suite.Nil(c)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Equal(testCase.expected, c.Get())
+			suite.NoError(c.Err())
+		})
+	}
+}
+
+func (suite *KVConfigSuite) TestMissingKey() {
+	_, err := NewKVConfig[testValue](context.Background(), suite.kv, KVConfigSource{Key: "missing"})
+	suite.ErrorIs(err, ErrKeyNotFound)
+}
+
+func (suite *KVConfigSuite) TestCanceled() {
+	suite.consul.SetKV("config", []byte(`{"count": 1}`))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	c, err := NewKVConfig[testValue](ctx, suite.kv, KVConfigSource{Key: "config"})
+	suite.ErrorIs(err, context.Canceled)
+	suite.Nil(c)
+}
+
+func (suite *KVConfigSuite) TestOnEvent() {
+	suite.consul.SetKV("config", []byte(`{"count": 1}`))
+	c, err := NewKVConfig[testValue](context.Background(), suite.kv, KVConfigSource{Key: "config"})
+	suite.Require().NoError(err)
+
+	var updates []testValue
+	c.AddListener(func(v testValue) {
+		updates = append(updates, v)
+	})
+
+	initial := suite.consul.KV("config")
+	c.OnEvent(KVWatchEvent{Pairs: api.KVPairs{initial}})
+	suite.Empty(updates)
+
+	c.OnEvent(KVWatchEvent{Err: errors.New("expected")})
+	suite.Error(c.Err())
+	suite.Equal(1, c.Get().Count)
+
+	// recovering without a change to the key clears the error
+	c.OnEvent(KVWatchEvent{Pairs: api.KVPairs{initial}})
+	suite.NoError(c.Err())
+	suite.Empty(updates)
+
+	c.OnEvent(KVWatchEvent{Err: errors.New("expected")})
+	suite.Error(c.Err())
+
+	c.OnEvent(KVWatchEvent{})
+	suite.ErrorIs(c.Err(), ErrKeyNotFound)
+
+	c.OnEvent(KVWatchEvent{Pairs: api.KVPairs{{Key: "config", ModifyIndex: 1000, Value: []byte(`{`)}}})
+	suite.Error(c.Err())
+	suite.Equal(1, c.Get().Count)
+	suite.Empty(updates)
+
+	c.OnEvent(KVWatchEvent{Pairs: api.KVPairs{{Key: "config", ModifyIndex: 1001, Value: []byte(`{"count": 2}`)}}})
+	suite.NoError(c.Err())
+	suite.Equal(2, c.Get().Count)
+	suite.Equal([]testValue{{Count: 2}}, updates)
+}
+
+func (suite *KVConfigSuite) TestProvideKVConfig() {
+	suite.consul.SetKV("app/config.yaml", []byte("name: initial\n"))
+
+	var (
+		initial testValue
+		c       *KVConfig[testValue]
+
+		app = fxtest.New(
+			suite.T(),
+			fx.Supply(suite.kv),
+			ProvideKVConfig[testValue](KVConfigSource{
+				Key:   "app/config.yaml",
+				Watch: true,
+			}),
+			fx.Populate(&initial, &c),
+		)
+	)
+
+	suite.Equal("initial", initial.Name)
+	app.RequireStart()
+
+	updated := make(chan testValue, 1)
+	c.AddListener(func(v testValue) {
+		updated <- v
+	})
+
+	suite.consul.SetKV("app/config.yaml", []byte("name: updated\n"))
+	select {
+	case v := <-updated:
+		suite.Equal("updated", v.Name)
+		suite.Equal("updated", c.Get().Name)
+
+	case <-time.After(5 * time.Second):
+		suite.Fail("no configuration update")
+	}
+
+	app.RequireStop()
+}
+
+func (suite *KVConfigSuite) TestProvideKVConfigRecovery() {
+	suite.consul.SetKV("app/config.yaml", []byte("name: initial\n"))
+
+	var (
+		c *KVConfig[testValue]
+
+		app = fxtest.New(
+			suite.T(),
+			fx.Supply(suite.kv),
+			ProvideKVConfig[testValue](KVConfigSource{
+				Key:           "app/config.yaml",
+				Watch:         true,
+				RetryInterval: 10 * time.Millisecond,
+				Options: api.QueryOptions{
+					WaitTime: 50 * time.Millisecond,
+				},
+			}),
+			fx.Populate(&c),
+		)
+	)
+
+	app.RequireStart()
+	defer app.RequireStop()
+
+	suite.consul.SetUnavailable(true)
+	suite.Eventually(func() bool { return c.Err() != nil }, 5*time.Second, 10*time.Millisecond)
+
+	// the key is unchanged, so only the recovery itself can clear the error
+	suite.consul.SetUnavailable(false)
+	suite.Eventually(func() bool { return c.Err() == nil }, 5*time.Second, 10*time.Millisecond)
+	suite.Equal("initial", c.Get().Name)
+}
+
+func (suite *KVConfigSuite) TestProvideKVConfigError() {
+	app := fx.New(
+		fx.NopLogger,
+		fx.Supply(suite.kv),
+		ProvideKVConfig[testValue](KVConfigSource{Key: "missing"}),
+		fx.Invoke(func(testValue) {}),
+	)
+
+	suite.ErrorIs(app.Err(), ErrKeyNotFound)
+	suite.Error(app.Start(context.Background()))
+}
+
+func TestKVConfig(t *testing.T) {
+	suite.Run(t, new(KVConfigSuite))
+}
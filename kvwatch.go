@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"go.uber.org/fx"
+)
+
+// DefaultKVWatchRetryInterval is the time a KVWatcher waits before retrying a
+// failed query when no RetryInterval is configured.
+const DefaultKVWatchRetryInterval = 5 * time.Second
+
+// ErrAlreadyStarted is returned by Start when a background component, such as
+// a KVWatcher or Elector, is already running.
+var ErrAlreadyStarted = errors.New("already started")
+
+// KVWatch describes the key or key prefix observed by a KVWatcher.
+type KVWatch struct {
+	// Key is the consul key to watch. If Prefix is true, this is the key prefix.
+	Key string `json:"key" yaml:"key" mapstructure:"key"`
+
+	// Prefix indicates whether Key is a single key or a prefix.
+	Prefix bool `json:"prefix" yaml:"prefix" mapstructure:"prefix"`
+
+	// RetryInterval is the time to wait before retrying a failed query.
+	// If unset, DefaultKVWatchRetryInterval is used.
+	RetryInterval time.Duration `json:"retryInterval" yaml:"retryInterval" mapstructure:"retryInterval"`
+
+	// Options are the base query options used for each blocking query, e.g. for
+	// datacenter or stale reads. WaitIndex and the context are managed by the watcher.
+	Options api.QueryOptions `json:"-" yaml:"-" mapstructure:"-"`
+}
+
+// KVWatchEvent describes the state of a watched key or prefix.
+type KVWatchEvent struct {
+	// Key is the watched key or prefix.
+	Key string
+
+	// Pairs holds the current pairs. For a single key watch, this will have
+	// at most one element. An empty Pairs means the key or prefix doesn't exist.
+	Pairs api.KVPairs
+
+	// Index is the consul index associated with this event.
+	Index uint64
+
+	// Err is any error that occurred while querying consul. When this is set,
+	// Pairs is not meaningful.
+	Err error
+}
+
+// KVListener is a sink for KVWatchEvents.
+type KVListener func(KVWatchEvent)
+
+// KVWatcher watches a consul key or key prefix using blocking queries and
+// dispatches a KVWatchEvent to listeners each time the watched data changes.
+type KVWatcher struct {
+	kv    *api.KV
+	watch KVWatch
+
+	listeners listeners[KVListener]
+
+	lock sync.Mutex
+	last KVWatchEvent
+
+	watcher watcher[KVWatchEvent]
+}
+
+// NewKVWatcher creates a KVWatcher for the given watch. The returned watcher
+// must be started before it will dispatch events.
+func NewKVWatcher(kv *api.KV, watch KVWatch) *KVWatcher {
+	if watch.RetryInterval <= 0 {
+		watch.RetryInterval = DefaultKVWatchRetryInterval
+	}
+
+	return &KVWatcher{
+		kv:      kv,
+		watch:   watch,
+		watcher: watcher[KVWatchEvent]{retryInterval: watch.RetryInterval},
+	}
+}
+
+// AddListener registers a listener for subsequent events. The returned
+// function removes the listener.
+func (w *KVWatcher) AddListener(l KVListener) (cancel func()) {
+	return w.listeners.add(l)
+}
+
+// Updates returns a channel that receives events from this watcher. The channel
+// only holds the most recent event: if the consumer falls behind, older events are
+// discarded so that the watcher never blocks. The returned function stops delivery
+// to the channel. The channel is never closed.
+func (w *KVWatcher) Updates() (<-chan KVWatchEvent, func()) {
+	ch := make(chan KVWatchEvent, 1)
+	var chLock sync.Mutex
+	cancel := w.AddListener(func(e KVWatchEvent) {
+		chLock.Lock()
+		defer chLock.Unlock()
+
+		select {
+		case <-ch:
+		default:
+		}
+
+		ch <- e
+	})
+
+	return ch, cancel
+}
+
+// Last returns the most recent event dispatched by this watcher.
+func (w *KVWatcher) Last() KVWatchEvent {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.last
+}
+
+// Start performs an initial, non-blocking query and dispatches its result, then
+// begins watching for changes in a background goroutine. If the initial query fails,
+// that error is returned and the watcher is not started.
+func (w *KVWatcher) Start(ctx context.Context) error {
+	return w.watcher.start(ctx, w.query, w.changed, w.dispatch)
+}
+
+// Stop halts the background goroutine started by Start, waiting until it exits
+// or the given context is canceled. This method is idempotent.
+func (w *KVWatcher) Stop(ctx context.Context) error {
+	return w.watcher.stop(ctx)
+}
+
+// query executes a single, possibly blocking, query against consul.
+func (w *KVWatcher) query(ctx context.Context, index uint64) (e KVWatchEvent, lastIndex uint64, err error) {
+	e.Key = w.watch.Key
+	q := w.watch.Options
+	q.WaitIndex = index
+
+	var meta *api.QueryMeta
+	if w.watch.Prefix {
+		e.Pairs, meta, e.Err = w.kv.List(w.watch.Key, q.WithContext(ctx))
+	} else {
+		var pair *api.KVPair
+		pair, meta, e.Err = w.kv.Get(w.watch.Key, q.WithContext(ctx))
+		if pair != nil {
+			e.Pairs = api.KVPairs{pair}
+		}
+	}
+
+	if meta != nil {
+		e.Index = meta.LastIndex
+	}
+
+	return e, e.Index, e.Err
+}
+
+// changed tests if an event holds different data than the last one dispatched.
+func (w *KVWatcher) changed(e KVWatchEvent) bool {
+	return !samePairs(w.Last().Pairs, e.Pairs)
+}
+
+// dispatch records an event and sends it to all listeners.
+func (w *KVWatcher) dispatch(e KVWatchEvent) {
+	if e.Err == nil {
+		// errors are transient and are not retained
+		w.lock.Lock()
+		w.last = e
+		w.lock.Unlock()
+	}
+
+	w.listeners.visit(func(l KVListener) {
+		l(e)
+	})
+}
+
+// samePairs tests if two sets of pairs represent the same data.
+func samePairs(a, b api.KVPairs) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].Key != b[i].Key || a[i].ModifyIndex != b[i].ModifyIndex {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ProvideKVWatcher emits a *KVWatcher with the given name, bound to the enclosing
+// application's lifecycle. The watcher's initial query happens when the application
+// starts, and the watcher is stopped when the application stops.
+//
+// This provider requires an *api.KV, such as the one emitted by Provide.
+func ProvideKVWatcher(name string, watch KVWatch) fx.Option {
+	return fx.Provide(
+		fx.Annotate(
+			func(kv *api.KV, l fx.Lifecycle) *KVWatcher {
+				w := NewKVWatcher(kv, watch)
+				l.Append(fx.StartStopHook(w.Start, w.Stop))
+				return w
+			},
+			fx.ResultTags(`name:"`+name+`"`),
+		),
+	)
+}
@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/praetor/praetortest"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+type KVWatcherSuite struct {
+	suite.Suite
+
+	consul *praetortest.Server
+	kv     *api.KV
+}
+
+func (suite *KVWatcherSuite) SetupTest() {
+	suite.consul = praetortest.NewServer(suite.T())
+	suite.kv = suite.consul.Client(suite.T()).KV()
+}
+
+// nextEvent waits for an event on the given channel.
+func (suite *KVWatcherSuite) nextEvent(ch <-chan KVWatchEvent) KVWatchEvent {
+	select {
+	case e := <-ch:
+		return e
+
+	case <-time.After(5 * time.Second):
+		suite.FailNow("no event received")
+		return KVWatchEvent{}
+	}
+}
+
+func (suite *KVWatcherSuite) start(w *KVWatcher) {
+	suite.Require().NoError(w.Start(context.Background()))
+	suite.T().Cleanup(func() {
+		suite.NoError(w.Stop(context.Background()))
+	})
+}
+
+func (suite *KVWatcherSuite) TestKey() {
+	suite.consul.SetKV("watched", []byte("first"))
+	w := NewKVWatcher(suite.kv, KVWatch{
+		Key: "watched",
+		Options: api.QueryOptions{
+			WaitTime: time.Second,
+		},
+	})
+
+	updates, cancel := w.Updates()
+	defer cancel()
+	suite.start(w)
+
+	e := suite.nextEvent(updates)
+	suite.NoError(e.Err)
+	suite.Equal("watched", e.Key)
+	suite.Require().Len(e.Pairs, 1)
+	suite.Equal("first", string(e.Pairs[0].Value))
+	suite.Equal(e, w.Last())
+
+	// changes to other keys should not produce events
+	suite.consul.SetKV("unrelated", []byte("unrelated"))
+	suite.consul.SetKV("watched", []byte("second"))
+	e = suite.nextEvent(updates)
+	suite.Require().Len(e.Pairs, 1)
+	suite.Equal("second", string(e.Pairs[0].Value))
+
+	suite.consul.DeleteKV("watched")
+	e = suite.nextEvent(updates)
+	suite.Empty(e.Pairs)
+	suite.Empty(w.Last().Pairs)
+}
+
+func (suite *KVWatcherSuite) TestPrefix() {
+	suite.consul.SetKV("prefix/a", []byte("a"))
+	w := NewKVWatcher(suite.kv, KVWatch{
+		Key:    "prefix/",
+		Prefix: true,
+	})
+
+	var events []KVWatchEvent
+	cancel := w.AddListener(func(e KVWatchEvent) {
+		events = append(events, e)
+	})
+
+	suite.start(w)
+	suite.Require().Len(events, 1)
+	suite.Len(events[0].Pairs, 1)
+	cancel()
+
+	updates, cancel := w.Updates()
+	defer cancel()
+	suite.consul.SetKV("prefix/b", []byte("b"))
+	e := suite.nextEvent(updates)
+	suite.Len(e.Pairs, 2)
+	suite.Len(events, 1)
+}
+
+func (suite *KVWatcherSuite) TestStartTwice() {
+	w := NewKVWatcher(suite.kv, KVWatch{Key: "key"})
+	suite.start(w)
+	suite.ErrorIs(w.Start(context.Background()), ErrAlreadyStarted)
+}
+
+func (suite *KVWatcherSuite) TestStopNotStarted() {
+	w := NewKVWatcher(suite.kv, KVWatch{Key: "key"})
+	suite.NoError(w.Stop(context.Background()))
+}
+
+func (suite *KVWatcherSuite) TestStartError() {
+	client, err := api.NewClient(&api.Config{
+		// nothing should be listening here
+		Address: "127.0.0.1:1",
+	})
+
+	suite.Require().NoError(err)
+	w := NewKVWatcher(client.KV(), KVWatch{Key: "key"})
+	suite.Error(w.Start(context.Background()))
+	suite.NoError(w.Stop(context.Background()))
+}
+
+func (suite *KVWatcherSuite) TestQueryError() {
+	suite.consul.SetKV("key", []byte("value"))
+	w := NewKVWatcher(suite.kv, KVWatch{
+		Key:           "key",
+		RetryInterval: 10 * time.Millisecond,
+	})
+
+	updates, cancel := w.Updates()
+	defer cancel()
+	suite.start(w)
+	suite.NoError(suite.nextEvent(updates).Err)
+
+	suite.consul.Close()
+	e := suite.nextEvent(updates)
+	suite.Error(e.Err)
+	suite.NoError(w.Last().Err)
+}
+
+func (suite *KVWatcherSuite) TestRecovery() {
+	suite.consul.SetKV("key", []byte("value"))
+	w := NewKVWatcher(suite.kv, KVWatch{
+		Key:           "key",
+		RetryInterval: 10 * time.Millisecond,
+		Options: api.QueryOptions{
+			WaitTime: 50 * time.Millisecond,
+		},
+	})
+
+	updates, cancel := w.Updates()
+	defer cancel()
+	suite.start(w)
+	initial := suite.nextEvent(updates)
+	suite.Require().NoError(initial.Err)
+
+	suite.consul.SetUnavailable(true)
+	for e := suite.nextEvent(updates); e.Err == nil; e = suite.nextEvent(updates) {
+	}
+
+	// the key hasn't changed, but listeners must still learn that consul recovered
+	suite.consul.SetUnavailable(false)
+	e := suite.nextEvent(updates)
+	for e.Err != nil {
+		e = suite.nextEvent(updates)
+	}
+
+	suite.Require().Len(e.Pairs, 1)
+	suite.Equal(initial.Pairs[0].ModifyIndex, e.Pairs[0].ModifyIndex)
+}
+
+func (suite *KVWatcherSuite) TestProvideKVWatcher() {
+	suite.consul.SetKV("provided", []byte("value"))
+
+	var w *KVWatcher
+	app := fxtest.New(
+		suite.T(),
+		fx.Supply(suite.kv),
+		ProvideKVWatcher("test", KVWatch{Key: "provided"}),
+		fx.Populate(
+			fx.Annotate(&w, fx.ParamTags(`name:"test"`)),
+		),
+	)
+
+	suite.Require().NotNil(w)
+	app.RequireStart()
+	suite.Require().Len(w.Last().Pairs, 1)
+	suite.Equal("value", string(w.Last().Pairs[0].Value))
+	app.RequireStop()
+}
+
+func TestKVWatcher(t *testing.T) {
+	suite.Run(t, new(KVWatcherSuite))
+}
@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"go.uber.org/fx"
+)
+
+// DefaultLeaderWatchInterval is the time between leader queries when no
+// Interval is configured.
+const DefaultLeaderWatchInterval = 10 * time.Second
+
+// LeaderWatch configures a LeaderWatcher.
+type LeaderWatch struct {
+	// Interval is the time between leader queries. Consul has no blocking query
+	// for the leader, so the watcher polls. If unset, DefaultLeaderWatchInterval
+	// is used.
+	Interval time.Duration `json:"interval" yaml:"interval" mapstructure:"interval"`
+
+	// Options are the query options used for each query, e.g. for datacenter.
+	// The context is managed by the watcher.
+	Options api.QueryOptions `json:"-" yaml:"-" mapstructure:"-"`
+}
+
+// LeaderEvent describes a change in the raft leader.
+type LeaderEvent struct {
+	// Leader is the raft address of the current leader. This is empty when the
+	// cluster has no leader.
+	Leader string
+
+	// Previous is the leader reported by the previous successful query.
+	Previous string
+
+	// Err is any error that occurred while querying consul. When this is set,
+	// Leader is not meaningful.
+	Err error
+}
+
+// LeaderListener is a sink for LeaderEvents.
+type LeaderListener func(LeaderEvent)
+
+// LeaderWatcher tracks the consul raft leader over time. Listeners are notified
+// when the leader changes or disappears, which is useful for logging election churn.
+// Query errors are dispatched once, when they begin, rather than on every poll.
+type LeaderWatcher struct {
+	status *api.Status
+	watch  LeaderWatch
+
+	listeners listeners[LeaderListener]
+
+	lock   sync.Mutex
+	leader string
+
+	poller  poller[LeaderEvent]
+	failing bool
+}
+
+// NewLeaderWatcher creates a LeaderWatcher. The returned watcher must be started
+// before it will dispatch events.
+func NewLeaderWatcher(client *api.Client, watch LeaderWatch) *LeaderWatcher {
+	if watch.Interval <= 0 {
+		watch.Interval = DefaultLeaderWatchInterval
+	}
+
+	return &LeaderWatcher{
+		status: client.Status(),
+		watch:  watch,
+		poller: poller[LeaderEvent]{interval: watch.Interval},
+	}
+}
+
+// AddListener registers a listener for subsequent events. The returned
+// function removes the listener.
+func (w *LeaderWatcher) AddListener(l LeaderListener) (cancel func()) {
+	return w.listeners.add(l)
+}
+
+// Leader returns the leader reported by the most recent successful query.
+func (w *LeaderWatcher) Leader() string {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.leader
+}
+
+// Start performs an initial query and dispatches its result, then begins polling
+// in a background goroutine. A failed initial query is dispatched as an event and
+// does not prevent the watcher from starting.
+func (w *LeaderWatcher) Start(ctx context.Context) error {
+	return w.poller.start(ctx, w.query, w.initial, w.next)
+}
+
+// Stop halts the background goroutine started by Start, waiting until it exits
+// or the given context is canceled. This method is idempotent.
+func (w *LeaderWatcher) Stop(ctx context.Context) error {
+	return w.poller.stop(ctx)
+}
+
+// query asks consul for the current leader.
+func (w *LeaderWatcher) query(ctx context.Context) (e LeaderEvent) {
+	q := w.watch.Options
+	q.WaitIndex = 0
+	e.Previous = w.Leader()
+	e.Leader, e.Err = w.status.LeaderWithQueryOptions(q.WithContext(ctx))
+	return
+}
+
+// dispatch records an event and sends it to all listeners.
+func (w *LeaderWatcher) dispatch(e LeaderEvent) {
+	if e.Err == nil {
+		w.lock.Lock()
+		w.leader = e.Leader
+		w.lock.Unlock()
+	}
+
+	w.listeners.visit(func(l LeaderListener) {
+		l(e)
+	})
+}
+
+// initial handles the result of the query made by Start.
+func (w *LeaderWatcher) initial(e LeaderEvent) {
+	w.failing = e.Err != nil
+	w.dispatch(e)
+}
+
+// next handles the result of each subsequent query. An error is dispatched only
+// when it begins, and the first success after an error is always dispatched.
+func (w *LeaderWatcher) next(e LeaderEvent) {
+	switch {
+	case e.Err != nil:
+		if !w.failing {
+			w.dispatch(e)
+			w.failing = true
+		}
+
+	case w.failing || e.Leader != e.Previous:
+		w.dispatch(e)
+		w.failing = false
+	}
+}
+
+// ProvideLeaderWatcher emits a *LeaderWatcher bound to the enclosing application's
+// lifecycle. The watcher's initial query happens when the application starts, and
+// the watcher is stopped when the application stops.
+//
+// This provider requires an *api.Client, such as the one emitted by Provide.
+func ProvideLeaderWatcher(watch LeaderWatch) fx.Option {
+	return fx.Provide(
+		func(client *api.Client, l fx.Lifecycle) *LeaderWatcher {
+			w := NewLeaderWatcher(client, watch)
+			l.Append(fx.StartStopHook(w.Start, w.Stop))
+			return w
+		},
+	)
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/praetor/praetortest"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+type LeaderWatcherSuite struct {
+	suite.Suite
+
+	consul *praetortest.Server
+	client *api.Client
+}
+
+func (suite *LeaderWatcherSuite) SetupTest() {
+	suite.consul = praetortest.NewServer(suite.T())
+	suite.client = suite.consul.Client(suite.T())
+}
+
+func (suite *LeaderWatcherSuite) nextEvent(events <-chan LeaderEvent) LeaderEvent {
+	select {
+	case e := <-events:
+		return e
+
+	case <-time.After(5 * time.Second):
+		suite.FailNow("no leader event")
+		return LeaderEvent{}
+	}
+}
+
+func (suite *LeaderWatcherSuite) TestDefaults() {
+	w := NewLeaderWatcher(suite.client, LeaderWatch{})
+	suite.Equal(DefaultLeaderWatchInterval, w.watch.Interval)
+	suite.Empty(w.Leader())
+	suite.NoError(w.Stop(context.Background()))
+}
+
+func (suite *LeaderWatcherSuite) TestWatch() {
+	w := NewLeaderWatcher(suite.client, LeaderWatch{Interval: 10 * time.Millisecond})
+	events := make(chan LeaderEvent, 10)
+	w.AddListener(func(e LeaderEvent) {
+		events <- e
+	})
+
+	suite.Require().NoError(w.Start(context.Background()))
+	defer w.Stop(context.Background())
+	suite.ErrorIs(w.Start(context.Background()), ErrAlreadyStarted)
+
+	e := suite.nextEvent(events)
+	suite.Equal(LeaderEvent{Leader: praetortest.LeaderAddress}, e)
+	suite.Equal(praetortest.LeaderAddress, w.Leader())
+
+	suite.consul.SetLeader("")
+	e = suite.nextEvent(events)
+	suite.Equal(LeaderEvent{Previous: praetortest.LeaderAddress}, e)
+	suite.Empty(w.Leader())
+
+	suite.consul.SetLeader("10.0.0.2:8300")
+	e = suite.nextEvent(events)
+	suite.Equal(LeaderEvent{Leader: "10.0.0.2:8300"}, e)
+
+	select {
+	case e := <-events:
+		suite.Failf("unchanged leader dispatched", "%v", e)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	suite.consul.Close()
+	e = suite.nextEvent(events)
+	suite.Error(e.Err)
+	suite.Equal("10.0.0.2:8300", w.Leader())
+
+	select {
+	case e := <-events:
+		suite.Failf("repeated error dispatched", "%v", e)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	suite.NoError(w.Stop(context.Background()))
+	suite.NoError(w.Stop(context.Background()))
+}
+
+func (suite *LeaderWatcherSuite) TestStartError() {
+	w := NewLeaderWatcher(suite.client, LeaderWatch{Interval: time.Hour})
+	events := make(chan LeaderEvent, 10)
+	w.AddListener(func(e LeaderEvent) {
+		events <- e
+	})
+
+	suite.consul.Close()
+	suite.Require().NoError(w.Start(context.Background()))
+
+	initial := <-events
+	suite.Error(initial.Err)
+	suite.Empty(w.Leader())
+	suite.NoError(w.Stop(context.Background()))
+}
+
+func (suite *LeaderWatcherSuite) TestProvideLeaderWatcher() {
+	var w *LeaderWatcher
+	app := fxtest.New(
+		suite.T(),
+		fx.Supply(suite.client),
+		ProvideLeaderWatcher(LeaderWatch{}),
+		fx.Populate(&w),
+	)
+
+	suite.Require().NotNil(w)
+	app.RequireStart()
+	suite.Equal(praetortest.LeaderAddress, w.Leader())
+	app.RequireStop()
+}
+
+func TestLeaderWatcher(t *testing.T) {
+	suite.Run(t, new(LeaderWatcherSuite))
+}
@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"go.uber.org/fx"
+)
+
+// DefaultLeafCertRetryInterval is the time a LeafCertManager waits before retrying
+// a failed query when no RetryInterval is configured.
+const DefaultLeafCertRetryInterval = 5 * time.Second
+
+// ErrNoLeafCert is returned by the tls callbacks of a LeafCertManager that
+// does not yet have a certificate.
+var ErrNoLeafCert = errors.New("no connect leaf certificate is available")
+
+// LeafCertConfig describes the service whose connect leaf certificate is
+// maintained by a LeafCertManager.
+type LeafCertConfig struct {
+	// ServiceID is the ID of the service, registered with the local agent, that
+	// the certificate identifies. This field is required.
+	ServiceID string `json:"serviceID" yaml:"serviceID" mapstructure:"serviceID"`
+
+	// RetryInterval is the time to wait before retrying a failed query.
+	// If unset, DefaultLeafCertRetryInterval is used.
+	RetryInterval time.Duration `json:"retryInterval" yaml:"retryInterval" mapstructure:"retryInterval"`
+
+	// Options are the base query options used for each blocking query.
+	// WaitIndex and the context are managed by the manager.
+	Options api.QueryOptions `json:"-" yaml:"-" mapstructure:"-"`
+}
+
+// LeafCertEvent describes a change to the certificate held by a LeafCertManager.
+type LeafCertEvent struct {
+	// Leaf is the certificate as returned by consul. This is nil when Err is set.
+	Leaf *api.LeafCert
+
+	// Certificate is Leaf parsed for use with crypto/tls. This is nil when Err is set.
+	Certificate *tls.Certificate
+
+	// Err is any error that occurred while querying consul or parsing the certificate.
+	// The manager retains its previous certificate when an error occurs.
+	Err error
+}
+
+// LeafCertListener is a sink for LeafCertEvents.
+type LeafCertListener func(LeafCertEvent)
+
+// LeafCertManager keeps a service's connect leaf certificate current. The local
+// agent renews leaf certificates before they expire, and this manager observes those
+// renewals with blocking queries. The GetCertificate and GetClientCertificate methods
+// can be used directly in a tls.Config, so that servers and clients always present the
+// current certificate.
+type LeafCertManager struct {
+	agent *api.Agent
+	cfg   LeafCertConfig
+
+	listeners listeners[LeafCertListener]
+
+	lock sync.Mutex
+	leaf *api.LeafCert
+	cert *tls.Certificate
+
+	watcher watcher[LeafCertEvent]
+}
+
+// NewLeafCertManager creates a LeafCertManager. No certificate is fetched until
+// the manager is started.
+func NewLeafCertManager(agent *api.Agent, cfg LeafCertConfig) *LeafCertManager {
+	if cfg.RetryInterval <= 0 {
+		cfg.RetryInterval = DefaultLeafCertRetryInterval
+	}
+
+	return &LeafCertManager{
+		agent:   agent,
+		cfg:     cfg,
+		watcher: watcher[LeafCertEvent]{retryInterval: cfg.RetryInterval},
+	}
+}
+
+// AddListener registers a listener for certificate changes. The returned
+// function removes the listener.
+func (m *LeafCertManager) AddListener(l LeafCertListener) (cancel func()) {
+	return m.listeners.add(l)
+}
+
+// Leaf returns the current certificate as returned by consul, or nil if the
+// manager has not been started.
+func (m *LeafCertManager) Leaf() *api.LeafCert {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.leaf
+}
+
+// Certificate returns the current certificate parsed for use with crypto/tls,
+// or nil if the manager has not been started.
+func (m *LeafCertManager) Certificate() *tls.Certificate {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.cert
+}
+
+// GetCertificate returns the current certificate. This method can be used
+// as tls.Config.GetCertificate.
+func (m *LeafCertManager) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	if cert := m.Certificate(); cert != nil {
+		return cert, nil
+	}
+
+	return nil, ErrNoLeafCert
+}
+
+// GetClientCertificate returns the current certificate. This method can be used
+// as tls.Config.GetClientCertificate.
+func (m *LeafCertManager) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return m.GetCertificate(nil)
+}
+
+// Start fetches the initial certificate, then begins watching for renewals in a
+// background goroutine. If the initial certificate cannot be obtained, that error
+// is returned and the manager is not started.
+func (m *LeafCertManager) Start(ctx context.Context) error {
+	return m.watcher.start(ctx, m.query, m.changed, m.dispatch)
+}
+
+// Stop halts the background goroutine started by Start, waiting until it exits
+// or the given context is canceled. The current certificate is retained. This
+// method is idempotent.
+func (m *LeafCertManager) Stop(ctx context.Context) error {
+	return m.watcher.stop(ctx)
+}
+
+// query executes a single, possibly blocking, query against consul.
+func (m *LeafCertManager) query(ctx context.Context, index uint64) (e LeafCertEvent, lastIndex uint64, err error) {
+	q := m.cfg.Options
+	q.WaitIndex = index
+
+	var meta *api.QueryMeta
+	e.Leaf, meta, e.Err = m.agent.ConnectCALeaf(m.cfg.ServiceID, q.WithContext(ctx))
+	if meta != nil {
+		lastIndex = meta.LastIndex
+	}
+
+	if e.Err == nil {
+		var cert tls.Certificate
+		cert, e.Err = tls.X509KeyPair([]byte(e.Leaf.CertPEM), []byte(e.Leaf.PrivateKeyPEM))
+		if e.Err == nil {
+			e.Certificate = &cert
+		} else {
+			e.Leaf = nil
+		}
+	}
+
+	return e, lastIndex, e.Err
+}
+
+// changed tests if an event holds a different certificate than the current one.
+func (m *LeafCertManager) changed(e LeafCertEvent) bool {
+	current := m.Leaf()
+	return current == nil || current.SerialNumber != e.Leaf.SerialNumber
+}
+
+// dispatch records an event and sends it to all listeners.
+func (m *LeafCertManager) dispatch(e LeafCertEvent) {
+	if e.Err == nil {
+		m.lock.Lock()
+		m.leaf, m.cert = e.Leaf, e.Certificate
+		m.lock.Unlock()
+	}
+
+	m.listeners.visit(func(l LeafCertListener) {
+		l(e)
+	})
+}
+
+// ProvideLeafCertManager emits a *LeafCertManager with the given name, bound to the
+// enclosing application's lifecycle. The initial certificate is fetched when the
+// application starts, and the manager is stopped when the application stops.
+//
+// This provider requires an *api.Agent, such as the one emitted by Provide.
+func ProvideLeafCertManager(name string, cfg LeafCertConfig) fx.Option {
+	return fx.Provide(
+		fx.Annotate(
+			func(agent *api.Agent, l fx.Lifecycle) *LeafCertManager {
+				m := NewLeafCertManager(agent, cfg)
+				l.Append(fx.StartStopHook(m.Start, m.Stop))
+				return m
+			},
+			fx.ResultTags(`name:"`+name+`"`),
+		),
+	)
+}
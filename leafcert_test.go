@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/praetor/praetortest"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+type LeafCertManagerSuite struct {
+	suite.Suite
+
+	consul *praetortest.Server
+	agent  *api.Agent
+}
+
+func (suite *LeafCertManagerSuite) SetupTest() {
+	suite.consul = praetortest.NewServer(suite.T())
+	suite.agent = suite.consul.Client(suite.T()).Agent()
+}
+
+func (suite *LeafCertManagerSuite) nextEvent(events <-chan LeafCertEvent) LeafCertEvent {
+	select {
+	case e := <-events:
+		return e
+
+	case <-time.After(5 * time.Second):
+		suite.FailNow("no leaf certificate event")
+		return LeafCertEvent{}
+	}
+}
+
+func (suite *LeafCertManagerSuite) TestDefaults() {
+	m := NewLeafCertManager(suite.agent, LeafCertConfig{ServiceID: "web"})
+	suite.Equal(DefaultLeafCertRetryInterval, m.cfg.RetryInterval)
+	suite.Nil(m.Leaf())
+	suite.Nil(m.Certificate())
+
+	_, err := m.GetCertificate(nil)
+	suite.ErrorIs(err, ErrNoLeafCert)
+
+	_, err = m.GetClientCertificate(nil)
+	suite.ErrorIs(err, ErrNoLeafCert)
+}
+
+func (suite *LeafCertManagerSuite) TestRenewal() {
+	m := NewLeafCertManager(suite.agent, LeafCertConfig{
+		ServiceID: "web",
+		Options: api.QueryOptions{
+			WaitTime: time.Second,
+		},
+	})
+
+	events := make(chan LeafCertEvent, 10)
+	m.AddListener(func(e LeafCertEvent) {
+		events <- e
+	})
+
+	suite.NoError(m.Stop(context.Background()))
+	suite.Require().NoError(m.Start(context.Background()))
+	defer m.Stop(context.Background())
+	suite.ErrorIs(m.Start(context.Background()), ErrAlreadyStarted)
+
+	initial := suite.nextEvent(events)
+	suite.Require().NoError(initial.Err)
+	suite.Require().NotNil(initial.Leaf)
+	suite.Require().NotNil(initial.Certificate)
+	suite.Equal("web", initial.Leaf.Service)
+	suite.Same(initial.Leaf, m.Leaf())
+
+	cert, err := m.GetCertificate(nil)
+	suite.Require().NoError(err)
+	suite.Same(initial.Certificate, cert)
+
+	cert, err = m.GetClientCertificate(nil)
+	suite.Require().NoError(err)
+	suite.Same(initial.Certificate, cert)
+
+	suite.Require().NoError(suite.consul.RotateLeafCert("web"))
+	renewed := suite.nextEvent(events)
+	suite.Require().NoError(renewed.Err)
+	suite.NotEqual(initial.Leaf.SerialNumber, renewed.Leaf.SerialNumber)
+	suite.Same(renewed.Certificate, m.Certificate())
+
+	// unrelated changes in consul do not produce events
+	suite.consul.SetKV("unrelated", []byte("value"))
+	time.Sleep(50 * time.Millisecond)
+	suite.Empty(events)
+}
+
+func (suite *LeafCertManagerSuite) TestRecovery() {
+	m := NewLeafCertManager(suite.agent, LeafCertConfig{
+		ServiceID:     "web",
+		RetryInterval: 10 * time.Millisecond,
+		Options: api.QueryOptions{
+			WaitTime: 50 * time.Millisecond,
+		},
+	})
+
+	events := make(chan LeafCertEvent, 100)
+	m.AddListener(func(e LeafCertEvent) {
+		events <- e
+	})
+
+	suite.Require().NoError(m.Start(context.Background()))
+	defer m.Stop(context.Background())
+	initial := suite.nextEvent(events)
+	suite.Require().NoError(initial.Err)
+
+	suite.consul.SetUnavailable(true)
+	suite.Error(suite.nextEvent(events).Err)
+
+	// the certificate hasn't changed, but listeners must still learn that consul recovered
+	suite.consul.SetUnavailable(false)
+	e := suite.nextEvent(events)
+	for e.Err != nil {
+		e = suite.nextEvent(events)
+	}
+
+	suite.Equal(initial.Leaf.SerialNumber, e.Leaf.SerialNumber)
+}
+
+func (suite *LeafCertManagerSuite) TestStartError() {
+	client, err := api.NewClient(&api.Config{
+		Address: "127.0.0.1:1",
+	})
+
+	suite.Require().NoError(err)
+	m := NewLeafCertManager(client.Agent(), LeafCertConfig{ServiceID: "web"})
+	suite.Error(m.Start(context.Background()))
+	suite.Nil(m.Leaf())
+}
+
+func (suite *LeafCertManagerSuite) TestProvideLeafCertManager() {
+	var m *LeafCertManager
+	app := fxtest.New(
+		suite.T(),
+		fx.Supply(suite.agent),
+		ProvideLeafCertManager("web", LeafCertConfig{ServiceID: "web"}),
+		fx.Populate(
+			fx.Annotate(&m, fx.ParamTags(`name:"web"`)),
+		),
+	)
+
+	suite.Require().NotNil(m)
+	app.RequireStart()
+	suite.NotNil(m.Certificate())
+	app.RequireStop()
+}
+
+func TestLeafCertManager(t *testing.T) {
+	suite.Run(t, new(LeafCertManagerSuite))
+}
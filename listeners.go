@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import "sync"
+
+// listeners is a simple, ordered registry of listeners of type L.
+type listeners[L any] struct {
+	lock    sync.Mutex
+	nextID  uint64
+	entries []listenerEntry[L]
+}
+
+type listenerEntry[L any] struct {
+	id uint64
+	l  L
+}
+
+// add appends a listener and returns a function that removes it.
+func (ls *listeners[L]) add(l L) (cancel func()) {
+	ls.lock.Lock()
+	id := ls.nextID
+	ls.nextID++
+	ls.entries = append(ls.entries, listenerEntry[L]{id: id, l: l})
+	ls.lock.Unlock()
+
+	return func() {
+		ls.lock.Lock()
+		defer ls.lock.Unlock()
+		for i, e := range ls.entries {
+			if e.id == id {
+				ls.entries = append(ls.entries[:i:i], ls.entries[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// visit invokes f for each listener. The registry is not locked while f runs,
+// so listeners may add or remove listeners.
+func (ls *listeners[L]) visit(f func(L)) {
+	ls.lock.Lock()
+	entries := ls.entries
+	ls.lock.Unlock()
+
+	for _, e := range entries {
+		f(e.l)
+	}
+}
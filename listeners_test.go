@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ListenersSuite struct {
+	suite.Suite
+}
+
+func (suite *ListenersSuite) TestAddAndCancel() {
+	var (
+		ls    listeners[func(int)]
+		calls []string
+
+		cancel1 = ls.add(func(v int) { calls = append(calls, "first") })
+		cancel2 = ls.add(func(v int) { calls = append(calls, "second") })
+	)
+
+	ls.visit(func(l func(int)) { l(1) })
+	suite.Equal([]string{"first", "second"}, calls)
+
+	calls = nil
+	cancel1()
+	cancel1() // idempotent
+	ls.visit(func(l func(int)) { l(1) })
+	suite.Equal([]string{"second"}, calls)
+
+	calls = nil
+	cancel2()
+	ls.visit(func(l func(int)) { l(1) })
+	suite.Empty(calls)
+}
+
+func (suite *ListenersSuite) TestCancelDuringVisit() {
+	var (
+		ls     listeners[func()]
+		calls  int
+		cancel func()
+	)
+
+	cancel = ls.add(func() {
+		calls++
+		cancel()
+	})
+
+	ls.add(func() { calls++ })
+
+	ls.visit(func(l func()) { l() })
+	suite.Equal(2, calls)
+
+	ls.visit(func(l func()) { l() })
+	suite.Equal(3, calls)
+}
+
+func TestListeners(t *testing.T) {
+	suite.Run(t, new(ListenersSuite))
+}
@@ -0,0 +1,267 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+)
+
+const (
+	// DefaultLoginRetryInterval is the time a Login waits after a failed
+	// refresh before trying again.
+	DefaultLoginRetryInterval = 5 * time.Second
+
+	// DefaultLoginTimeout is the time allowed for the login that Provide performs
+	// while constructing the client.
+	DefaultLoginTimeout = 15 * time.Second
+
+	// tokenHeader is the HTTP header consul uses to carry ACL tokens.
+	tokenHeader = "X-Consul-Token"
+)
+
+// LoginConfig describes how to obtain an ACL token by logging in to a consul
+// auth method, such as a JWT or Kubernetes auth method.
+type LoginConfig struct {
+	// AuthMethod is the name of the consul auth method. If unset, no login is performed.
+	AuthMethod string `json:"authMethod" yaml:"authMethod" mapstructure:"authMethod"`
+
+	// BearerToken is the credential presented to the auth method, e.g. a JWT.
+	BearerToken string `json:"bearerToken" yaml:"bearerToken" mapstructure:"bearerToken"`
+
+	// BearerTokenFile is a file containing the credential presented to the auth method.
+	// The file is reread for each login, which accommodates credentials that are rotated
+	// on disk such as Kubernetes projected service account tokens. This field takes
+	// precedence over BearerToken.
+	BearerTokenFile string `json:"bearerTokenFile" yaml:"bearerTokenFile" mapstructure:"bearerTokenFile"`
+
+	// Meta is optional metadata attached to the tokens created by a login.
+	Meta map[string]string `json:"meta" yaml:"meta" mapstructure:"meta"`
+
+	// RetryInterval is the time to wait after a failed refresh before trying again.
+	// If unset, DefaultLoginRetryInterval is used.
+	RetryInterval time.Duration `json:"retryInterval" yaml:"retryInterval" mapstructure:"retryInterval"`
+
+	// Timeout is the time allowed for the initial login when the client is created
+	// by Provide. That login blocks the construction of the fx application. If unset,
+	// DefaultLoginTimeout is used.
+	Timeout time.Duration `json:"timeout" yaml:"timeout" mapstructure:"timeout"`
+}
+
+// Login maintains an ACL token obtained from a consul auth method. The token is
+// installed on the client as a default request header, so every request made through
+// that client uses it. If the token has an expiration time, a new login is performed
+// once two thirds of the token's lifetime has elapsed.
+//
+// The client must not be configured with its own token, either through api.Config or
+// the CONSUL_HTTP_TOKEN environment variable, as that token takes precedence over the
+// one installed by a Login.
+type Login struct {
+	client        *api.Client
+	acl           *api.ACL
+	cfg           LoginConfig
+	retryInterval time.Duration
+	timeout       time.Duration
+
+	tokenLock sync.Mutex
+	token     *api.ACLToken
+
+	loginLock sync.Mutex
+
+	runner runner
+}
+
+// NewLogin creates a Login for the given client. No login is performed until
+// the Login is authenticated or started.
+func NewLogin(client *api.Client, cfg LoginConfig) *Login {
+	l := &Login{
+		client:        client,
+		acl:           client.ACL(),
+		cfg:           cfg,
+		retryInterval: cfg.RetryInterval,
+		timeout:       cfg.Timeout,
+	}
+
+	if l.retryInterval <= 0 {
+		l.retryInterval = DefaultLoginRetryInterval
+	}
+
+	if l.timeout <= 0 {
+		l.timeout = DefaultLoginTimeout
+	}
+
+	return l
+}
+
+// Token returns the secret ID of the current ACL token. This method returns
+// the empty string if the Login is not running.
+func (l *Login) Token() string {
+	l.tokenLock.Lock()
+	defer l.tokenLock.Unlock()
+	if l.token != nil {
+		return l.token.SecretID
+	}
+
+	return ""
+}
+
+// Authenticate performs the initial login if this Login does not already hold a
+// token. Unlike Start, no background refreshes are started. This allows a client
+// to be authorized before anything else uses it.
+func (l *Login) Authenticate(ctx context.Context) error {
+	l.loginLock.Lock()
+	defer l.loginLock.Unlock()
+	if len(l.Token()) > 0 {
+		return nil
+	}
+
+	token, err := l.login(ctx)
+	if err != nil {
+		return err
+	}
+
+	l.setToken(token)
+	return nil
+}
+
+// Start performs the initial login, unless Authenticate has already done so, and
+// begins refreshing the token in a background goroutine. If the initial login fails,
+// that error is returned.
+func (l *Login) Start(ctx context.Context) error {
+	return l.runner.start(func() (func(context.Context), error) {
+		if err := l.Authenticate(ctx); err != nil {
+			return nil, err
+		}
+
+		return l.run, nil
+	})
+}
+
+// Stop halts token refreshes and logs out, which destroys the current token.
+// A token obtained by Authenticate is destroyed even if the Login was never
+// started. This method is idempotent.
+func (l *Login) Stop(ctx context.Context) error {
+	if err := l.runner.stop(ctx); err != nil {
+		return err
+	}
+
+	secretID := l.Token()
+	l.setToken(nil)
+	return l.logout(ctx, secretID)
+}
+
+// login obtains a new token from the auth method.
+func (l *Login) login(ctx context.Context) (*api.ACLToken, error) {
+	bearerToken := l.cfg.BearerToken
+	if len(l.cfg.BearerTokenFile) > 0 {
+		data, err := os.ReadFile(l.cfg.BearerTokenFile)
+		if err != nil {
+			return nil, err
+		}
+
+		bearerToken = strings.TrimSpace(string(data))
+	}
+
+	token, _, err := l.acl.Login(
+		&api.ACLLoginParams{
+			AuthMethod:  l.cfg.AuthMethod,
+			BearerToken: bearerToken,
+			Meta:        l.cfg.Meta,
+		},
+		new(api.WriteOptions).WithContext(ctx),
+	)
+
+	return token, err
+}
+
+// logout destroys the given token. An empty secretID is ignored.
+func (l *Login) logout(ctx context.Context, secretID string) error {
+	if len(secretID) == 0 {
+		return nil
+	}
+
+	_, err := l.acl.Logout(
+		(&api.WriteOptions{Token: secretID}).WithContext(ctx),
+	)
+
+	return err
+}
+
+// setToken updates the current token and the client's token header.
+func (l *Login) setToken(token *api.ACLToken) {
+	l.tokenLock.Lock()
+	defer l.tokenLock.Unlock()
+	l.token = token
+
+	headers := l.client.Headers()
+	if headers == nil {
+		headers = make(http.Header)
+	}
+
+	if token != nil {
+		headers.Set(tokenHeader, token.SecretID)
+	} else {
+		headers.Del(tokenHeader)
+	}
+
+	l.client.SetHeaders(headers)
+}
+
+// refreshInterval computes the time to wait before logging in again. A token
+// without an expiration time never needs to be refreshed, in which case this
+// method returns false.
+func (l *Login) refreshInterval() (time.Duration, bool) {
+	l.tokenLock.Lock()
+	defer l.tokenLock.Unlock()
+	if l.token == nil || l.token.ExpirationTime == nil {
+		return 0, false
+	}
+
+	return time.Until(*l.token.ExpirationTime) * 2 / 3, true
+}
+
+// run is the background refresh loop.
+func (l *Login) run(ctx context.Context) {
+	wait, ok := l.refreshInterval()
+	if !ok {
+		<-ctx.Done()
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+
+		token, err := l.login(ctx)
+		switch {
+		case ctx.Err() != nil:
+			return
+
+		case err != nil:
+			// the current token may still be valid, so try again soon
+			wait = l.retryInterval
+			continue
+		}
+
+		previous := l.Token()
+		l.setToken(token)
+
+		// the previous token is no longer used, so this is best effort
+		l.logout(ctx, previous)
+
+		if wait, ok = l.refreshInterval(); !ok {
+			<-ctx.Done()
+			return
+		}
+	}
+}
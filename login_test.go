@@ -0,0 +1,271 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/praetor/praetortest"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+type LoginSuite struct {
+	suite.Suite
+
+	consul *praetortest.Server
+	client *api.Client
+}
+
+func (suite *LoginSuite) SetupTest() {
+	suite.consul = praetortest.NewServer(suite.T())
+	suite.client = suite.consul.Client(suite.T())
+}
+
+// registeredToken registers a service and returns the token the fake consul received.
+func (suite *LoginSuite) registeredToken() string {
+	suite.Require().NoError(
+		suite.client.Agent().ServiceRegister(&api.AgentServiceRegistration{Name: "test"}),
+	)
+
+	registrations := suite.consul.Registrations()
+	suite.Require().NotEmpty(registrations)
+	return registrations[len(registrations)-1].Token
+}
+
+func (suite *LoginSuite) TestDefaults() {
+	l := NewLogin(suite.client, LoginConfig{})
+	suite.Equal(DefaultLoginRetryInterval, l.retryInterval)
+	suite.Equal(DefaultLoginTimeout, l.timeout)
+
+	l = NewLogin(suite.client, LoginConfig{RetryInterval: time.Second, Timeout: time.Minute})
+	suite.Equal(time.Second, l.retryInterval)
+	suite.Equal(time.Minute, l.timeout)
+	suite.Empty(l.Token())
+}
+
+func (suite *LoginSuite) TestLifecycle() {
+	suite.consul.AddAuthMethod("jwt", "bearer", 0)
+	l := NewLogin(suite.client, LoginConfig{
+		AuthMethod:  "jwt",
+		BearerToken: "bearer",
+	})
+
+	suite.NoError(l.Stop(context.Background()))
+	suite.Require().NoError(l.Start(context.Background()))
+	suite.ErrorIs(l.Start(context.Background()), ErrAlreadyStarted)
+
+	token := l.Token()
+	suite.NotEmpty(token)
+	suite.Equal([]string{token}, suite.consul.LoginTokens())
+	suite.Equal(token, suite.registeredToken())
+
+	suite.NoError(l.Stop(context.Background()))
+	suite.Empty(l.Token())
+	suite.Empty(suite.consul.LoginTokens())
+	suite.Empty(suite.registeredToken())
+}
+
+func (suite *LoginSuite) TestRefresh() {
+	suite.consul.AddAuthMethod("jwt", "bearer", 60*time.Millisecond)
+	l := NewLogin(suite.client, LoginConfig{
+		AuthMethod:  "jwt",
+		BearerToken: "bearer",
+	})
+
+	suite.Require().NoError(l.Start(context.Background()))
+	defer l.Stop(context.Background())
+
+	first := l.Token()
+	suite.Eventually(
+		func() bool {
+			token := l.Token()
+			tokens := suite.consul.LoginTokens()
+			return token != first && len(tokens) == 1 && tokens[0] == token
+		},
+		5*time.Second,
+		10*time.Millisecond,
+	)
+}
+
+func (suite *LoginSuite) TestBearerTokenFile() {
+	path := filepath.Join(suite.T().TempDir(), "token")
+	suite.Require().NoError(os.WriteFile(path, []byte("bearer\n"), 0600))
+
+	suite.consul.AddAuthMethod("kubernetes", "bearer", 0)
+	l := NewLogin(suite.client, LoginConfig{
+		AuthMethod:      "kubernetes",
+		BearerToken:     "ignored",
+		BearerTokenFile: path,
+	})
+
+	suite.Require().NoError(l.Start(context.Background()))
+	suite.NotEmpty(l.Token())
+	suite.NoError(l.Stop(context.Background()))
+}
+
+func (suite *LoginSuite) TestStartError() {
+	suite.Run("Denied", func() {
+		suite.consul.AddAuthMethod("jwt", "bearer", 0)
+		l := NewLogin(suite.client, LoginConfig{
+			AuthMethod:  "jwt",
+			BearerToken: "wrong",
+		})
+
+		suite.Error(l.Start(context.Background()))
+		suite.Empty(l.Token())
+	})
+
+	suite.Run("MissingBearerTokenFile", func() {
+		l := NewLogin(suite.client, LoginConfig{
+			AuthMethod:      "jwt",
+			BearerTokenFile: filepath.Join(suite.T().TempDir(), "missing"),
+		})
+
+		suite.Error(l.Start(context.Background()))
+		suite.Empty(l.Token())
+	})
+}
+
+func (suite *LoginSuite) TestProvide() {
+	suite.consul.AddAuthMethod("jwt", "bearer", 0)
+
+	var (
+		client *api.Client
+
+		app = fxtest.New(
+			suite.T(),
+			fx.Supply(
+				Config{
+					Scheme:  "http",
+					Address: suite.consul.Address(),
+					Login: LoginConfig{
+						AuthMethod:  "jwt",
+						BearerToken: "bearer",
+					},
+				},
+			),
+			ProvideConfig(),
+			Provide(),
+			fx.Populate(&client),
+		)
+	)
+
+	// the client logs in as soon as it is created
+	suite.Require().NotNil(client)
+	suite.Require().Len(suite.consul.LoginTokens(), 1)
+	suite.Equal(suite.consul.LoginTokens()[0], client.Headers().Get("X-Consul-Token"))
+
+	app.RequireStart()
+	suite.Len(suite.consul.LoginTokens(), 1)
+	suite.Equal(suite.consul.LoginTokens()[0], client.Headers().Get("X-Consul-Token"))
+
+	app.RequireStop()
+	suite.Empty(suite.consul.LoginTokens())
+}
+
+func (suite *LoginSuite) TestProvideEnforcedACLs() {
+	suite.consul.AddAuthMethod("jwt", "bearer", 0)
+	suite.consul.EnforceACLs(true)
+	suite.consul.SetKV("app/config.yaml", []byte("name: initial\n"))
+
+	var (
+		info   AgentInfo
+		config testValue
+
+		app = fxtest.New(
+			suite.T(),
+			fx.Supply(
+				Config{
+					Scheme:  "http",
+					Address: suite.consul.Address(),
+					Login: LoginConfig{
+						AuthMethod:  "jwt",
+						BearerToken: "bearer",
+					},
+				},
+			),
+			ProvideConfig(),
+			Provide(),
+			ProvideAgentInfo(),
+			ProvideKVConfig[testValue](KVConfigSource{
+				Key:   "app/config.yaml",
+				Watch: true,
+			}),
+			fx.Populate(&info, &config),
+		)
+	)
+
+	suite.Require().NoError(app.Err())
+	suite.Equal(praetortest.NodeName, info.NodeName)
+	suite.Equal("initial", config.Name)
+
+	app.RequireStart()
+	app.RequireStop()
+	suite.Empty(suite.consul.LoginTokens())
+}
+
+func (suite *LoginSuite) TestProvideLoginError() {
+	suite.consul.AddAuthMethod("jwt", "bearer", 0)
+
+	var client *api.Client
+	app := fx.New(
+		fx.NopLogger,
+		fx.Supply(
+			Config{
+				Scheme:  "http",
+				Address: suite.consul.Address(),
+				Login: LoginConfig{
+					AuthMethod:  "jwt",
+					BearerToken: "wrong",
+				},
+			},
+		),
+		ProvideConfig(),
+		Provide(),
+		fx.Populate(&client),
+	)
+
+	suite.Error(app.Err())
+	suite.Nil(client)
+}
+
+func (suite *LoginSuite) TestAuthenticate() {
+	suite.consul.AddAuthMethod("jwt", "bearer", 0)
+	l := NewLogin(suite.client, LoginConfig{
+		AuthMethod:  "jwt",
+		BearerToken: "bearer",
+	})
+
+	suite.Require().NoError(l.Authenticate(context.Background()))
+	token := l.Token()
+	suite.NotEmpty(token)
+	suite.Equal(token, suite.registeredToken())
+
+	// neither a second Authenticate nor Start logs in again
+	suite.Require().NoError(l.Authenticate(context.Background()))
+	suite.Require().NoError(l.Start(context.Background()))
+	suite.Equal(token, l.Token())
+	suite.Equal([]string{token}, suite.consul.LoginTokens())
+
+	suite.NoError(l.Stop(context.Background()))
+	suite.Empty(l.Token())
+	suite.Empty(suite.consul.LoginTokens())
+
+	// a token obtained without Start is still logged out by Stop
+	suite.Require().NoError(l.Authenticate(context.Background()))
+	suite.Len(suite.consul.LoginTokens(), 1)
+	suite.NoError(l.Stop(context.Background()))
+	suite.Empty(suite.consul.LoginTokens())
+}
+
+func TestLogin(t *testing.T) {
+	suite.Run(t, new(LoginSuite))
+}
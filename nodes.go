@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"sort"
+
+	"github.com/hashicorp/consul/api"
+)
+
+// NodeQuery selects the catalog nodes returned by Nodes.Get.
+type NodeQuery struct {
+	// Services indicates whether the services registered on each node are fetched
+	// as well. This costs one additional request per node.
+	Services bool
+
+	// ServiceFilter is an optional consul filter expression applied to each node's
+	// services. This field is ignored unless Services is set.
+	ServiceFilter string
+
+	// Options are the query options used for each request, e.g. for datacenter,
+	// node metadata, or a filter expression applied to the nodes themselves.
+	// Options.Filter only applies to the node list: each request for a node's
+	// services uses ServiceFilter in its place.
+	Options api.QueryOptions
+}
+
+// NodeInfo describes a catalog node.
+type NodeInfo struct {
+	// Node is the catalog node.
+	Node *api.Node
+
+	// Services are the node's services, sorted by ID. This is only populated
+	// when NodeQuery.Services is set.
+	Services []*api.AgentService
+}
+
+// Nodes enumerates the nodes in the consul catalog along with the services
+// registered on them. This is mainly useful for infrastructure tooling, as
+// applications usually discover services rather than nodes.
+type Nodes struct {
+	catalog *api.Catalog
+}
+
+// NewNodes creates a Nodes that uses the given consul catalog client.
+func NewNodes(catalog *api.Catalog) *Nodes {
+	return &Nodes{
+		catalog: catalog,
+	}
+}
+
+// Get returns the nodes selected by q, in the order consul reports them. A node
+// that disappears before its services can be fetched is omitted.
+func (n *Nodes) Get(q NodeQuery) ([]NodeInfo, error) {
+	options := q.Options
+	nodes, _, err := n.catalog.Nodes(&options)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]NodeInfo, 0, len(nodes))
+	for _, node := range nodes {
+		info := NodeInfo{Node: node}
+		if q.Services {
+			services, ok, err := n.nodeServices(node.Node, q)
+			if err != nil {
+				return nil, err
+			} else if !ok {
+				continue
+			}
+
+			info.Services = services
+		}
+
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
+
+// nodeServices fetches the services on a single node. The returned bool is false
+// if the node no longer exists.
+func (n *Nodes) nodeServices(node string, q NodeQuery) ([]*api.AgentService, bool, error) {
+	// the node filter doesn't apply to services
+	options := q.Options
+	options.Filter = q.ServiceFilter
+	list, _, err := n.catalog.NodeServiceList(node, &options)
+	if err != nil || list == nil || list.Node == nil {
+		return nil, false, err
+	}
+
+	sort.Slice(list.Services, func(i, j int) bool {
+		return list.Services[i].ID < list.Services[j].ID
+	})
+
+	return list.Services, true, nil
+}
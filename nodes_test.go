@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/praetor/praetortest"
+)
+
+type NodesSuite struct {
+	suite.Suite
+
+	consul *praetortest.Server
+	client *api.Client
+	nodes  *Nodes
+}
+
+func (suite *NodesSuite) SetupTest() {
+	suite.consul = praetortest.NewServer(suite.T())
+	suite.client = suite.consul.Client(suite.T())
+	suite.nodes = NewNodes(suite.client.Catalog())
+
+	suite.Require().NoError(
+		NewCatalogTxn().
+			SetNode(api.Node{Node: "db", Address: "10.0.0.2"}).
+			SetNode(api.Node{Node: "web", Address: "10.0.0.1"}).
+			SetService("web", api.AgentService{ID: "web-2", Service: "web", Port: 8081}).
+			SetService("web", api.AgentService{ID: "web-1", Service: "web", Port: 8080}).
+			Execute(context.Background(), suite.client.Txn()),
+	)
+}
+
+func (suite *NodesSuite) TestGet() {
+	infos, err := suite.nodes.Get(NodeQuery{})
+	suite.Require().NoError(err)
+	suite.Require().Len(infos, 2)
+
+	suite.Equal("db", infos[0].Node.Node)
+	suite.Equal("10.0.0.2", infos[0].Node.Address)
+	suite.Nil(infos[0].Services)
+
+	suite.Equal("web", infos[1].Node.Node)
+	suite.Nil(infos[1].Services)
+}
+
+func (suite *NodesSuite) TestGetServices() {
+	infos, err := suite.nodes.Get(NodeQuery{Services: true})
+	suite.Require().NoError(err)
+	suite.Require().Len(infos, 2)
+
+	suite.Equal("db", infos[0].Node.Node)
+	suite.Empty(infos[0].Services)
+
+	suite.Equal("web", infos[1].Node.Node)
+	suite.Require().Len(infos[1].Services, 2)
+	suite.Equal("web-1", infos[1].Services[0].ID)
+	suite.Equal(8080, infos[1].Services[0].Port)
+	suite.Equal("web-2", infos[1].Services[1].ID)
+}
+
+func (suite *NodesSuite) TestGetError() {
+	suite.consul.Close()
+	infos, err := suite.nodes.Get(NodeQuery{Services: true})
+	suite.Error(err)
+	suite.Nil(infos)
+}
+
+func TestNodes(t *testing.T) {
+	suite.Run(t, new(NodesSuite))
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetortest
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+)
+
+// authMethod is a simulated consul auth method.
+type authMethod struct {
+	bearerToken string
+	ttl         time.Duration
+}
+
+func (s *Server) aclRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /v1/acl/login", s.aclLogin)
+	mux.HandleFunc("POST /v1/acl/logout", s.aclLogout)
+}
+
+// AddAuthMethod defines an auth method that accepts logins presenting the given
+// bearer token. Tokens issued by the method expire after ttl. A nonpositive ttl
+// means that tokens do not expire.
+func (s *Server) AddAuthMethod(name, bearerToken string, ttl time.Duration) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.authMethods[name] = authMethod{
+		bearerToken: bearerToken,
+		ttl:         ttl,
+	}
+}
+
+// EnforceACLs controls whether this server rejects requests that do not carry a
+// token issued by a login. Logins themselves are always allowed. By default, ACLs
+// are not enforced.
+func (s *Server) EnforceACLs(enforce bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.enforceACLs = enforce
+}
+
+// authorized tests whether a request is allowed under the current ACL enforcement.
+func (s *Server) authorized(r *http.Request) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.enforceACLs || r.URL.Path == "/v1/acl/login" {
+		return true
+	}
+
+	_, ok := s.loginTokens[token(r)]
+	return ok
+}
+
+// LoginTokens returns the sorted secret IDs of all tokens issued by a login
+// that have not been logged out. Expiry is not simulated, so expired tokens
+// are included.
+func (s *Server) LoginTokens() (secretIDs []string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for secretID := range s.loginTokens {
+		secretIDs = append(secretIDs, secretID)
+	}
+
+	sort.Strings(secretIDs)
+	return
+}
+
+func (s *Server) aclLogin(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var params api.ACLLoginParams
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	method, ok := s.authMethods[params.AuthMethod]
+	if !ok || method.bearerToken != params.BearerToken {
+		http.Error(w, "Permission denied", http.StatusForbidden)
+		return
+	}
+
+	s.nextTokenID++
+	id := strconv.Itoa(s.nextTokenID)
+	t := &api.ACLToken{
+		AccessorID:  "accessor-" + id,
+		SecretID:    "secret-" + id,
+		AuthMethod:  params.AuthMethod,
+		CreateTime:  time.Now(),
+		CreateIndex: s.bump(),
+	}
+
+	if method.ttl > 0 {
+		expiration := t.CreateTime.Add(method.ttl)
+		t.ExpirationTTL = method.ttl
+		t.ExpirationTime = &expiration
+	}
+
+	s.loginTokens[t.SecretID] = t
+	writeJSON(w, http.StatusOK, t)
+}
+
+func (s *Server) aclLogout(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	secretID := token(r)
+	if _, ok := s.loginTokens[secretID]; !ok {
+		http.Error(w, "ACL not found", http.StatusForbidden)
+		return
+	}
+
+	delete(s.loginTokens, secretID)
+	s.bump()
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetortest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+)
+
+type ACLSuite struct {
+	suite.Suite
+
+	server *Server
+	acl    *api.ACL
+}
+
+func (suite *ACLSuite) SetupTest() {
+	suite.server = NewServer(suite.T())
+	suite.acl = suite.server.Client(suite.T()).ACL()
+}
+
+func (suite *ACLSuite) TestLoginLogout() {
+	suite.server.AddAuthMethod("jwt", "bearer", time.Minute)
+	t, _, err := suite.acl.Login(&api.ACLLoginParams{AuthMethod: "jwt", BearerToken: "bearer"}, nil)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(t)
+	suite.NotEmpty(t.SecretID)
+	suite.Equal("jwt", t.AuthMethod)
+	suite.Equal(time.Minute, t.ExpirationTTL)
+	suite.Require().NotNil(t.ExpirationTime)
+	suite.Equal([]string{t.SecretID}, suite.server.LoginTokens())
+
+	_, err = suite.acl.Logout(&api.WriteOptions{Token: t.SecretID})
+	suite.Require().NoError(err)
+	suite.Empty(suite.server.LoginTokens())
+
+	_, err = suite.acl.Logout(&api.WriteOptions{Token: t.SecretID})
+	suite.Error(err)
+}
+
+func (suite *ACLSuite) TestNoExpiration() {
+	suite.server.AddAuthMethod("jwt", "bearer", 0)
+	t, _, err := suite.acl.Login(&api.ACLLoginParams{AuthMethod: "jwt", BearerToken: "bearer"}, nil)
+	suite.Require().NoError(err)
+	suite.Nil(t.ExpirationTime)
+}
+
+func (suite *ACLSuite) TestLoginDenied() {
+	suite.server.AddAuthMethod("jwt", "bearer", time.Minute)
+
+	_, _, err := suite.acl.Login(&api.ACLLoginParams{AuthMethod: "jwt", BearerToken: "wrong"}, nil)
+	suite.Error(err)
+
+	_, _, err = suite.acl.Login(&api.ACLLoginParams{AuthMethod: "missing", BearerToken: "bearer"}, nil)
+	suite.Error(err)
+	suite.Empty(suite.server.LoginTokens())
+}
+
+func (suite *ACLSuite) TestEnforceACLs() {
+	suite.server.AddAuthMethod("jwt", "bearer", 0)
+	suite.server.EnforceACLs(true)
+
+	client := suite.server.Client(suite.T())
+	_, _, err := client.KV().Get("key", nil)
+	suite.Error(err)
+
+	t, _, err := suite.acl.Login(&api.ACLLoginParams{AuthMethod: "jwt", BearerToken: "bearer"}, nil)
+	suite.Require().NoError(err)
+
+	_, _, err = client.KV().Get("key", &api.QueryOptions{Token: "wrong"})
+	suite.Error(err)
+
+	_, _, err = client.KV().Get("key", &api.QueryOptions{Token: t.SecretID})
+	suite.NoError(err)
+
+	suite.server.EnforceACLs(false)
+	_, _, err = client.KV().Get("key", nil)
+	suite.NoError(err)
+}
+
+func TestACL(t *testing.T) {
+	suite.Run(t, new(ACLSuite))
+}
@@ -0,0 +1,364 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetortest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/consul/api"
+)
+
+// RegisterRequest is a service registration received by a Server.
+type RegisterRequest struct {
+	// Registration is the decoded request body.
+	Registration api.AgentServiceRegistration
+
+	// ReplaceExistingChecks is the value of the replace-existing-checks parameter.
+	ReplaceExistingChecks bool
+
+	// Token is the ACL token sent with the request, if any.
+	Token string
+}
+
+// CheckUpdate is a TTL check update received by a Server.
+type CheckUpdate struct {
+	// Status is the consul health status, e.g. api.HealthPassing.
+	Status string
+
+	// Output is the check output sent with the update.
+	Output string
+}
+
+func (s *Server) agentRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("PUT /v1/agent/service/register", s.agentServiceRegister)
+	mux.HandleFunc("PUT /v1/agent/service/deregister/{id}", s.agentServiceDeregister)
+	mux.HandleFunc("PUT /v1/agent/check/update/{id}", s.agentCheckUpdate)
+	mux.HandleFunc("PUT /v1/agent/check/pass/{id}", s.agentCheckStatus(api.HealthPassing))
+	mux.HandleFunc("PUT /v1/agent/check/warn/{id}", s.agentCheckStatus(api.HealthWarning))
+	mux.HandleFunc("PUT /v1/agent/check/fail/{id}", s.agentCheckStatus(api.HealthCritical))
+	mux.HandleFunc("GET /v1/agent/services", s.agentServices)
+	mux.HandleFunc("GET /v1/agent/checks", s.agentChecks)
+	mux.HandleFunc("GET /v1/agent/self", s.agentSelf)
+}
+
+// Registrations returns every service registration received, in order.
+func (s *Server) Registrations() []RegisterRequest {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]RegisterRequest(nil), s.registrations...)
+}
+
+// Deregistrations returns the service IDs of every deregistration received, in order.
+// This includes requests for services that were not registered.
+func (s *Server) Deregistrations() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]string(nil), s.deregistrations...)
+}
+
+// Service returns the current registration for a service ID. The returned
+// bool is false if no such service is registered.
+func (s *Server) Service(serviceID string) (api.AgentServiceRegistration, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if reg, ok := s.services[serviceID]; ok {
+		return *reg, true
+	}
+
+	return api.AgentServiceRegistration{}, false
+}
+
+// Check returns the current state of a check. The returned bool is false if
+// no such check exists.
+func (s *Server) Check(checkID string) (api.AgentCheck, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if check, ok := s.checks[checkID]; ok {
+		return *check, true
+	}
+
+	return api.AgentCheck{}, false
+}
+
+// CheckUpdates returns every TTL update received for a check, in order.
+func (s *Server) CheckUpdates(checkID string) []CheckUpdate {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]CheckUpdate(nil), s.checkUpdates[checkID]...)
+}
+
+// SetCheck sets the state of an existing check directly, simulating the agent
+// evaluating a non-TTL check such as an HTTP check. The returned bool is false
+// if no such check exists. This method does not record a CheckUpdate.
+func (s *Server) SetCheck(checkID, status, output string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.setCheck(checkID, status, output)
+}
+
+// setCheck updates a check's state. The lock must be held.
+func (s *Server) setCheck(checkID, status, output string) bool {
+	check, ok := s.checks[checkID]
+	if ok {
+		check.Status, check.Output = status, output
+		s.bump()
+	}
+
+	return ok
+}
+
+// checkType returns the consul type name of a check definition.
+func checkType(c *api.AgentServiceCheck) string {
+	switch {
+	case len(c.TTL) > 0:
+		return "ttl"
+	case len(c.HTTP) > 0:
+		return "http"
+	case len(c.TCP) > 0:
+		return "tcp"
+	case len(c.GRPC) > 0:
+		return "grpc"
+	case len(c.AliasService) > 0:
+		return "alias"
+	default:
+		return "script"
+	}
+}
+
+// serviceChecks returns the checks for a registration using the same ID rules as consul:
+// checks without an explicit CheckID are named after the service.
+func serviceChecks(reg *api.AgentServiceRegistration) []*api.AgentCheck {
+	var defs api.AgentServiceChecks
+	if reg.Check != nil {
+		defs = append(defs, reg.Check)
+	}
+
+	defs = append(defs, reg.Checks...)
+	checks := make([]*api.AgentCheck, 0, len(defs))
+	for i, def := range defs {
+		check := &api.AgentCheck{
+			Node:        NodeName,
+			CheckID:     def.CheckID,
+			Name:        def.Name,
+			Status:      def.Status,
+			Notes:       def.Notes,
+			ServiceID:   reg.ID,
+			ServiceName: reg.Name,
+			Type:        checkType(def),
+		}
+
+		switch {
+		case len(check.CheckID) > 0:
+		case len(defs) == 1:
+			check.CheckID = "service:" + reg.ID
+		default:
+			check.CheckID = "service:" + reg.ID + ":" + strconv.Itoa(i+1)
+		}
+
+		if len(check.Name) == 0 {
+			check.Name = "Service '" + reg.Name + "' check"
+		}
+
+		if len(check.Status) == 0 {
+			check.Status = api.HealthCritical
+		}
+
+		checks = append(checks, check)
+	}
+
+	return checks
+}
+
+func (s *Server) agentServiceRegister(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var reg api.AgentServiceRegistration
+	if err := json.NewDecoder(r.Body).Decode(&reg); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if len(reg.Name) == 0 {
+		http.Error(w, "Missing service name", http.StatusBadRequest)
+		return
+	}
+
+	if len(reg.ID) == 0 {
+		reg.ID = reg.Name
+	}
+
+	replace, _ := strconv.ParseBool(r.URL.Query().Get("replace-existing-checks"))
+	s.registrations = append(s.registrations, RegisterRequest{
+		Registration:          reg,
+		ReplaceExistingChecks: replace,
+		Token:                 token(r),
+	})
+
+	if replace {
+		s.deleteServiceChecks(reg.ID)
+	}
+
+	for _, check := range serviceChecks(&reg) {
+		if existing, ok := s.checks[check.CheckID]; ok {
+			// like consul, re-registering a check retains its state
+			check.Status, check.Output = existing.Status, existing.Output
+		}
+
+		s.checks[check.CheckID] = check
+	}
+
+	s.services[reg.ID] = &reg
+	s.bump()
+	w.WriteHeader(http.StatusOK)
+}
+
+// deleteServiceChecks removes all checks associated with a service. The lock must be held.
+func (s *Server) deleteServiceChecks(serviceID string) {
+	for id, check := range s.checks {
+		if check.ServiceID == serviceID {
+			delete(s.checks, id)
+		}
+	}
+}
+
+func (s *Server) agentServiceDeregister(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	serviceID := r.PathValue("id")
+	s.deregistrations = append(s.deregistrations, serviceID)
+	if _, ok := s.services[serviceID]; !ok {
+		http.Error(w, "Unknown service ID "+strconv.Quote(serviceID), http.StatusNotFound)
+		return
+	}
+
+	delete(s.services, serviceID)
+	s.deleteServiceChecks(serviceID)
+	s.bump()
+	w.WriteHeader(http.StatusOK)
+}
+
+// updateTTL records a TTL update and applies it to a check. The lock must be held.
+func (s *Server) updateTTL(w http.ResponseWriter, checkID string, update CheckUpdate) {
+	check, ok := s.checks[checkID]
+	if !ok || check.Type != "ttl" {
+		http.Error(w, "Unknown check ID "+strconv.Quote(checkID), http.StatusNotFound)
+		return
+	}
+
+	s.checkUpdates[checkID] = append(s.checkUpdates[checkID], update)
+	s.setCheck(checkID, update.Status, update.Output)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) agentCheckUpdate(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var update CheckUpdate
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	switch update.Status {
+	case api.HealthPassing, api.HealthWarning, api.HealthCritical:
+		s.updateTTL(w, r.PathValue("id"), update)
+
+	default:
+		http.Error(w, "Invalid check status "+strconv.Quote(update.Status), http.StatusBadRequest)
+	}
+}
+
+// agentCheckStatus handles the legacy pass/warn/fail TTL endpoints.
+func (s *Server) agentCheckStatus(status string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		s.updateTTL(w, r.PathValue("id"), CheckUpdate{
+			Status: status,
+			Output: r.URL.Query().Get("note"),
+		})
+	}
+}
+
+// agentService converts a registration into the agent's view of a service.
+func agentService(reg *api.AgentServiceRegistration) *api.AgentService {
+	svc := &api.AgentService{
+		ID:                reg.ID,
+		Service:           reg.Name,
+		Tags:              reg.Tags,
+		Port:              reg.Port,
+		Address:           reg.Address,
+		Meta:              reg.Meta,
+		TaggedAddresses:   reg.TaggedAddresses,
+		EnableTagOverride: reg.EnableTagOverride,
+		Weights:           api.AgentWeights{Passing: 1, Warning: 1},
+		Namespace:         reg.Namespace,
+		Partition:         reg.Partition,
+		Datacenter:        Datacenter,
+		Locality:          reg.Locality,
+	}
+
+	if reg.Weights != nil {
+		svc.Weights = *reg.Weights
+	}
+
+	if len(reg.Kind) > 0 {
+		svc.Kind = reg.Kind
+	}
+
+	return svc
+}
+
+func (s *Server) agentServices(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	services := make(map[string]*api.AgentService, len(s.services))
+	for id, reg := range s.services {
+		services[id] = agentService(reg)
+	}
+
+	writeJSON(w, http.StatusOK, services)
+}
+
+func (s *Server) agentChecks(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	writeJSON(w, http.StatusOK, s.checks)
+}
+
+// agentSelf reports the subset of the agent's configuration and membership
+// that clients typically use to identify the agent.
+func (s *Server) agentSelf(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]map[string]any{
+		"Config": {
+			"NodeName":   NodeName,
+			"Datacenter": Datacenter,
+			"Version":    Version,
+		},
+		"Member": {
+			"Name": NodeName,
+			"Addr": NodeAddress,
+		},
+	})
+}
+
+// token extracts the ACL token from a request, using the same sources as consul.
+func token(r *http.Request) string {
+	if t := r.Header.Get("X-Consul-Token"); len(t) > 0 {
+		return t
+	}
+
+	if t, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		return t
+	}
+
+	return r.URL.Query().Get("token")
+}
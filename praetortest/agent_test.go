@@ -0,0 +1,212 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetortest
+
+import (
+	"testing"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+)
+
+type AgentSuite struct {
+	suite.Suite
+
+	server *Server
+	agent  *api.Agent
+}
+
+func (suite *AgentSuite) SetupTest() {
+	suite.server = NewServer(suite.T())
+	suite.agent = suite.server.Client(suite.T()).Agent()
+}
+
+func (suite *AgentSuite) register(reg api.AgentServiceRegistration, opts api.ServiceRegisterOpts) {
+	suite.Require().NoError(
+		suite.agent.ServiceRegisterOpts(&reg, opts),
+	)
+}
+
+func (suite *AgentSuite) TestRegister() {
+	suite.register(
+		api.AgentServiceRegistration{
+			ID:   "web-1",
+			Name: "web",
+			Tags: []string{"a", "b"},
+			Port: 8080,
+			Check: &api.AgentServiceCheck{
+				TTL: "10s",
+			},
+		},
+		api.ServiceRegisterOpts{
+			ReplaceExistingChecks: true,
+			Token:                 "token",
+		},
+	)
+
+	registrations := suite.server.Registrations()
+	suite.Require().Len(registrations, 1)
+	suite.Equal("web-1", registrations[0].Registration.ID)
+	suite.True(registrations[0].ReplaceExistingChecks)
+	suite.Equal("token", registrations[0].Token)
+
+	reg, ok := suite.server.Service("web-1")
+	suite.True(ok)
+	suite.Equal(8080, reg.Port)
+
+	check, ok := suite.server.Check("service:web-1")
+	suite.Require().True(ok)
+	suite.Equal(api.HealthCritical, check.Status)
+	suite.Equal("ttl", check.Type)
+	suite.Equal("web-1", check.ServiceID)
+
+	services, err := suite.agent.Services()
+	suite.Require().NoError(err)
+	suite.Require().Contains(services, "web-1")
+	suite.Equal("web", services["web-1"].Service)
+	suite.Equal([]string{"a", "b"}, services["web-1"].Tags)
+
+	checks, err := suite.agent.Checks()
+	suite.Require().NoError(err)
+	suite.Contains(checks, "service:web-1")
+}
+
+func (suite *AgentSuite) TestRegisterDefaults() {
+	suite.register(
+		api.AgentServiceRegistration{
+			Name: "web",
+			Checks: api.AgentServiceChecks{
+				{TTL: "10s", Status: api.HealthPassing},
+				{HTTP: "http://localhost/health", Interval: "10s"},
+				{CheckID: "custom", TCP: "localhost:8080", Interval: "10s"},
+			},
+		},
+		api.ServiceRegisterOpts{},
+	)
+
+	_, ok := suite.server.Service("web")
+	suite.True(ok)
+
+	first, ok := suite.server.Check("service:web:1")
+	suite.Require().True(ok)
+	suite.Equal(api.HealthPassing, first.Status)
+
+	second, ok := suite.server.Check("service:web:2")
+	suite.Require().True(ok)
+	suite.Equal("http", second.Type)
+
+	custom, ok := suite.server.Check("custom")
+	suite.Require().True(ok)
+	suite.Equal("tcp", custom.Type)
+}
+
+func (suite *AgentSuite) TestRegisterInvalid() {
+	suite.Error(suite.agent.ServiceRegister(&api.AgentServiceRegistration{}))
+	suite.Empty(suite.server.Registrations())
+}
+
+func (suite *AgentSuite) TestReplaceExistingChecks() {
+	suite.register(
+		api.AgentServiceRegistration{
+			Name: "web",
+			Checks: api.AgentServiceChecks{
+				{CheckID: "first", TTL: "10s"},
+				{CheckID: "second", TTL: "10s"},
+			},
+		},
+		api.ServiceRegisterOpts{},
+	)
+
+	suite.Require().NoError(suite.agent.UpdateTTL("first", "ok", api.HealthPassing))
+
+	updated := api.AgentServiceRegistration{
+		Name: "web",
+		Checks: api.AgentServiceChecks{
+			{CheckID: "first", TTL: "10s"},
+		},
+	}
+
+	suite.register(updated, api.ServiceRegisterOpts{})
+	_, ok := suite.server.Check("second")
+	suite.True(ok)
+
+	first, _ := suite.server.Check("first")
+	suite.Equal(api.HealthPassing, first.Status, "re-registering a check should retain its state")
+
+	suite.register(updated, api.ServiceRegisterOpts{ReplaceExistingChecks: true})
+	_, ok = suite.server.Check("second")
+	suite.False(ok)
+}
+
+func (suite *AgentSuite) TestDeregister() {
+	suite.register(
+		api.AgentServiceRegistration{
+			ID:    "web-1",
+			Name:  "web",
+			Check: &api.AgentServiceCheck{TTL: "10s"},
+		},
+		api.ServiceRegisterOpts{},
+	)
+
+	suite.Error(suite.agent.ServiceDeregister("nosuchservice"))
+	suite.NoError(suite.agent.ServiceDeregister("web-1"))
+	suite.Equal([]string{"nosuchservice", "web-1"}, suite.server.Deregistrations())
+
+	_, ok := suite.server.Service("web-1")
+	suite.False(ok)
+
+	_, ok = suite.server.Check("service:web-1")
+	suite.False(ok)
+}
+
+func (suite *AgentSuite) TestUpdateTTL() {
+	suite.register(
+		api.AgentServiceRegistration{
+			ID:   "web-1",
+			Name: "web",
+			Checks: api.AgentServiceChecks{
+				{CheckID: "ttl", TTL: "10s"},
+				{CheckID: "http", HTTP: "http://localhost/health", Interval: "10s"},
+			},
+		},
+		api.ServiceRegisterOpts{},
+	)
+
+	suite.NoError(suite.agent.UpdateTTL("ttl", "all good", api.HealthPassing))
+	suite.NoError(suite.agent.UpdateTTL("ttl", "hmm", "warn"))
+	suite.NoError(suite.agent.WarnTTL("ttl", "legacy"))
+	suite.Error(suite.agent.UpdateTTL("http", "not a ttl check", api.HealthPassing))
+	suite.Error(suite.agent.UpdateTTL("nosuchcheck", "", api.HealthPassing))
+
+	suite.Equal(
+		[]CheckUpdate{
+			{Status: api.HealthPassing, Output: "all good"},
+			{Status: api.HealthWarning, Output: "hmm"},
+			{Status: api.HealthWarning, Output: "legacy"},
+		},
+		suite.server.CheckUpdates("ttl"),
+	)
+
+	check, _ := suite.server.Check("ttl")
+	suite.Equal(api.HealthWarning, check.Status)
+	suite.Equal("legacy", check.Output)
+
+	suite.True(suite.server.SetCheck("http", api.HealthPassing, "200 OK"))
+	suite.False(suite.server.SetCheck("nosuchcheck", api.HealthPassing, ""))
+	check, _ = suite.server.Check("http")
+	suite.Equal(api.HealthPassing, check.Status)
+	suite.Empty(suite.server.CheckUpdates("http"))
+}
+
+func (suite *AgentSuite) TestSelf() {
+	self, err := suite.agent.Self()
+	suite.Require().NoError(err)
+	suite.Equal(NodeName, self["Config"]["NodeName"])
+	suite.Equal(Datacenter, self["Config"]["Datacenter"])
+	suite.Equal(Version, self["Config"]["Version"])
+}
+
+func TestAgent(t *testing.T) {
+	suite.Run(t, new(AgentSuite))
+}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetortest
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/consul/api"
+)
+
+func (s *Server) catalogRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/catalog/services", s.catalogServices)
+	mux.HandleFunc("GET /v1/catalog/nodes", s.catalogNodes)
+	mux.HandleFunc("GET /v1/catalog/node-services/{node}", s.catalogNodeServices)
+}
+
+// catalogServices reports each registered service name along with the
+// sorted union of the tags of its instances.
+func (s *Server) catalogServices(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.block(r)
+
+	services := make(map[string][]string)
+	for _, reg := range s.services {
+		tags := services[reg.Name]
+		for _, tag := range reg.Tags {
+			if !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+
+		sort.Strings(tags)
+		if tags == nil {
+			tags = []string{}
+		}
+
+		services[reg.Name] = tags
+	}
+
+	s.writeQueryResponse(w, http.StatusOK, services)
+}
+
+// catalogNodes reports the nodes registered through catalog transactions, sorted by name.
+func (s *Server) catalogNodes(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.block(r)
+
+	nodes := make([]*api.Node, 0, len(s.catalog.nodes))
+	for _, node := range s.catalog.nodes {
+		nodes = append(nodes, node)
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Node < nodes[j].Node
+	})
+
+	s.writeQueryResponse(w, http.StatusOK, nodes)
+}
+
+// catalogNodeServices reports a node registered through catalog transactions along
+// with its services. Like consul, an unknown node results in a null body.
+func (s *Server) catalogNodeServices(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.block(r)
+
+	node, ok := s.catalog.nodes[r.PathValue("node")]
+	if !ok {
+		s.writeQueryResponse(w, http.StatusOK, json.RawMessage("null"))
+		return
+	}
+
+	list := &api.CatalogNodeServiceList{
+		Node:     node,
+		Services: []*api.AgentService{},
+	}
+
+	for _, service := range s.catalog.services[node.Node] {
+		list.Services = append(list.Services, service)
+	}
+
+	s.writeQueryResponse(w, http.StatusOK, list)
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetortest
+
+import (
+	"testing"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+)
+
+type CatalogSuite struct {
+	suite.Suite
+
+	server *Server
+	client *api.Client
+}
+
+func (suite *CatalogSuite) SetupTest() {
+	suite.server = NewServer(suite.T())
+	suite.client = suite.server.Client(suite.T())
+}
+
+func (suite *CatalogSuite) register(reg *api.AgentServiceRegistration) {
+	suite.Require().NoError(suite.client.Agent().ServiceRegister(reg))
+}
+
+func (suite *CatalogSuite) TestServices() {
+	services, _, err := suite.client.Catalog().Services(nil)
+	suite.Require().NoError(err)
+	suite.Empty(services)
+
+	suite.register(&api.AgentServiceRegistration{ID: "web-1", Name: "web", Tags: []string{"b", "a"}})
+	suite.register(&api.AgentServiceRegistration{ID: "web-2", Name: "web", Tags: []string{"c", "a"}})
+	suite.register(&api.AgentServiceRegistration{Name: "db"})
+
+	services, meta, err := suite.client.Catalog().Services(nil)
+	suite.Require().NoError(err)
+	suite.Equal(
+		map[string][]string{
+			"web": {"a", "b", "c"},
+			"db":  {},
+		},
+		services,
+	)
+
+	err = suite.client.Agent().ServiceDeregister("db")
+	suite.Require().NoError(err)
+
+	services, _, err = suite.client.Catalog().Services(&api.QueryOptions{WaitIndex: meta.LastIndex})
+	suite.Require().NoError(err)
+	suite.Equal(map[string][]string{"web": {"a", "b", "c"}}, services)
+}
+
+func (suite *CatalogSuite) TestNodes() {
+	nodes, _, err := suite.client.Catalog().Nodes(nil)
+	suite.Require().NoError(err)
+	suite.Empty(nodes)
+
+	ok, response, _, err := suite.client.Txn().Txn(
+		api.TxnOps{
+			{Node: &api.NodeTxnOp{Verb: api.NodeSet, Node: api.Node{Node: "b", Address: "10.0.0.2"}}},
+			{Node: &api.NodeTxnOp{Verb: api.NodeSet, Node: api.Node{Node: "a", Address: "10.0.0.1"}}},
+			{Service: &api.ServiceTxnOp{Verb: api.ServiceSet, Node: "a", Service: api.AgentService{ID: "web-1", Service: "web"}}},
+		},
+		nil,
+	)
+
+	suite.Require().NoError(err)
+	suite.Require().True(ok, "%v", response)
+
+	nodes, _, err = suite.client.Catalog().Nodes(nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(nodes, 2)
+	suite.Equal("a", nodes[0].Node)
+	suite.Equal("10.0.0.1", nodes[0].Address)
+	suite.Equal("b", nodes[1].Node)
+
+	list, _, err := suite.client.Catalog().NodeServiceList("a", nil)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(list)
+	suite.Equal("a", list.Node.Node)
+	suite.Require().Len(list.Services, 1)
+	suite.Equal("web-1", list.Services[0].ID)
+
+	list, _, err = suite.client.Catalog().NodeServiceList("b", nil)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(list)
+	suite.Empty(list.Services)
+
+	list, _, err = suite.client.Catalog().NodeServiceList("missing", nil)
+	suite.Require().NoError(err)
+	suite.Nil(list)
+}
+
+func TestCatalog(t *testing.T) {
+	suite.Run(t, new(CatalogSuite))
+}
@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetortest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+)
+
+// LeafCertTTL is the validity period of leaf certificates issued by a Server.
+const LeafCertTTL = 72 * time.Hour
+
+// intention identifies a source and destination service.
+type intention struct {
+	source, destination string
+}
+
+func (s *Server) connectRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/agent/connect/ca/leaf/{service}", s.connectLeaf)
+	mux.HandleFunc("GET /v1/connect/intentions/check", s.connectIntentionCheck)
+}
+
+// SetIntention defines whether connections from a source service to a destination
+// service are allowed. Connections between services with no intention are allowed,
+// as they are in a consul agent without ACLs.
+func (s *Server) SetIntention(source, destination string, allowed bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.intentions[intention{source: source, destination: destination}] = allowed
+	s.bump()
+}
+
+// IntentionChecks returns the number of intention checks this server has answered.
+func (s *Server) IntentionChecks() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.intentionChecks
+}
+
+// LeafCert returns the leaf certificate most recently issued for a service, if any.
+func (s *Server) LeafCert(serviceID string) (api.LeafCert, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if leaf, ok := s.leafCerts[serviceID]; ok {
+		return *leaf, true
+	}
+
+	return api.LeafCert{}, false
+}
+
+// RotateLeafCert issues a new leaf certificate for a service, as consul does
+// when a certificate nears expiry or the CA changes. Blocking queries for the
+// service's certificate are woken.
+func (s *Server) RotateLeafCert(serviceID string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	leaf, err := newLeafCert(serviceID, s.bump())
+	if err == nil {
+		s.leafCerts[serviceID] = leaf
+	}
+
+	return err
+}
+
+// newLeafCert creates a self-signed certificate carrying the service's SPIFFE identity.
+func newLeafCert(serviceID string, index uint64) (*api.LeafCert, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		now        = time.Now()
+		serviceURI = &url.URL{
+			Scheme: "spiffe",
+			Host:   "praetortest.consul",
+			Path:   "/ns/default/dc/" + Datacenter + "/svc/" + serviceID,
+		}
+
+		template = &x509.Certificate{
+			SerialNumber: serial,
+			Subject:      pkix.Name{CommonName: serviceID},
+			URIs:         []*url.URL{serviceURI},
+			NotBefore:    now.Add(-time.Minute),
+			NotAfter:     now.Add(LeafCertTTL),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		}
+	)
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.LeafCert{
+		SerialNumber:  serial.Text(16),
+		CertPEM:       string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		PrivateKeyPEM: string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+		Service:       serviceID,
+		ServiceURI:    serviceURI.String(),
+		ValidAfter:    template.NotBefore,
+		ValidBefore:   template.NotAfter,
+		CreateIndex:   index,
+		ModifyIndex:   index,
+	}, nil
+}
+
+func (s *Server) connectLeaf(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	serviceID := r.PathValue("service")
+	if _, ok := s.leafCerts[serviceID]; !ok {
+		leaf, err := newLeafCert(serviceID, s.index)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		s.leafCerts[serviceID] = leaf
+	}
+
+	s.block(r)
+	s.writeQueryResponse(w, http.StatusOK, s.leafCerts[serviceID])
+}
+
+func (s *Server) connectIntentionCheck(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	allowed, ok := s.intentions[intention{
+		source:      r.URL.Query().Get("source"),
+		destination: r.URL.Query().Get("destination"),
+	}]
+
+	s.intentionChecks++
+	s.writeQueryResponse(w, http.StatusOK, map[string]bool{"Allowed": allowed || !ok})
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetortest
+
+import (
+	"crypto/tls"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+)
+
+type ConnectSuite struct {
+	suite.Suite
+
+	server *Server
+	agent  *api.Agent
+}
+
+func (suite *ConnectSuite) SetupTest() {
+	suite.server = NewServer(suite.T())
+	suite.agent = suite.server.Client(suite.T()).Agent()
+}
+
+func (suite *ConnectSuite) TestLeaf() {
+	_, ok := suite.server.LeafCert("web")
+	suite.False(ok)
+
+	leaf, meta, err := suite.agent.ConnectCALeaf("web", nil)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(leaf)
+	suite.Equal("web", leaf.Service)
+	suite.Contains(leaf.ServiceURI, "/svc/web")
+	suite.True(leaf.ValidBefore.After(time.Now()))
+
+	_, err = tls.X509KeyPair([]byte(leaf.CertPEM), []byte(leaf.PrivateKeyPEM))
+	suite.NoError(err)
+
+	stored, ok := suite.server.LeafCert("web")
+	suite.True(ok)
+	suite.Equal(leaf.SerialNumber, stored.SerialNumber)
+
+	// the same certificate is returned until it is rotated
+	again, _, err := suite.agent.ConnectCALeaf("web", nil)
+	suite.Require().NoError(err)
+	suite.Equal(leaf.SerialNumber, again.SerialNumber)
+
+	suite.Require().NoError(suite.server.RotateLeafCert("web"))
+	rotated, _, err := suite.agent.ConnectCALeaf("web", &api.QueryOptions{WaitIndex: meta.LastIndex})
+	suite.Require().NoError(err)
+	suite.NotEqual(leaf.SerialNumber, rotated.SerialNumber)
+	suite.Greater(rotated.ModifyIndex, leaf.ModifyIndex)
+}
+
+func (suite *ConnectSuite) TestIntentionCheck() {
+	connect := suite.server.Client(suite.T()).Connect()
+	check := func(source, destination string) bool {
+		allowed, _, err := connect.IntentionCheck(
+			&api.IntentionCheck{Source: source, Destination: destination},
+			nil,
+		)
+
+		suite.Require().NoError(err)
+		return allowed
+	}
+
+	suite.True(check("web", "db"))
+
+	suite.server.SetIntention("web", "db", false)
+	suite.False(check("web", "db"))
+	suite.True(check("db", "web"))
+
+	suite.server.SetIntention("web", "db", true)
+	suite.True(check("web", "db"))
+	suite.Equal(4, suite.server.IntentionChecks())
+}
+
+func TestConnect(t *testing.T) {
+	suite.Run(t, new(ConnectSuite))
+}
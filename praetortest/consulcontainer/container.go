@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package consulcontainer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/xmidt-org/praetor"
+)
+
+const (
+	// DefaultImage is the consul image started when no image is given.
+	DefaultImage = "hashicorp/consul:1.20"
+
+	// httpPort is the port of the agent's HTTP API inside the container.
+	httpPort = "8500/tcp"
+
+	// leaderTimeout is how long Start waits for the agent to elect itself leader.
+	leaderTimeout = 30 * time.Second
+)
+
+// errNoLeader indicates that the containerized agent never elected a leader.
+var errNoLeader = errors.New("the consul container did not elect a leader")
+
+// Container is a consul agent running in dev mode inside a container.
+type Container struct {
+	id      string
+	address string
+}
+
+// Start runs the given consul image, or DefaultImage if image is empty, and waits
+// until the agent is ready to accept registrations. The container is removed when
+// the given test completes. If docker is not installed, the test is skipped.
+func Start(tb testing.TB, image string) *Container {
+	tb.Helper()
+	if _, err := exec.LookPath("docker"); err != nil {
+		tb.Skip("docker is not installed")
+	}
+
+	if len(image) == 0 {
+		image = DefaultImage
+	}
+
+	// publish the HTTP API on an ephemeral loopback port
+	id, err := docker("run", "--detach", "--publish", "127.0.0.1::8500", image, "agent", "-dev", "-client", "0.0.0.0")
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	c := &Container{id: id}
+	tb.Cleanup(func() {
+		if _, err := docker("rm", "--force", "--volumes", c.id); err != nil {
+			tb.Errorf("unable to remove consul container: %s", err)
+		}
+	})
+
+	if c.address, err = publishedAddress(c.id); err != nil {
+		tb.Fatal(err)
+	}
+
+	if err := c.waitForLeader(tb); err != nil {
+		tb.Fatal(err)
+	}
+
+	return c
+}
+
+// Address returns the host:port of the agent's HTTP API, suitable for praetor.Config.Address.
+func (c *Container) Address() string {
+	return c.address
+}
+
+// Config returns a praetor.Config for the containerized agent.
+func (c *Container) Config() praetor.Config {
+	return praetor.Config{
+		Scheme:  "http",
+		Address: c.address,
+	}
+}
+
+// Client returns a consul client for the containerized agent, built from Config the
+// same way praetor.ProvideConfig does.
+func (c *Container) Client(tb testing.TB) *api.Client {
+	tb.Helper()
+	cfg, err := praetor.NewAPIConfig(c.Config())
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	client, err := api.NewClient(&cfg)
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	return client
+}
+
+// waitForLeader blocks until the agent reports a raft leader. A dev mode agent
+// answers HTTP requests slightly before it can accept registrations.
+func (c *Container) waitForLeader(tb testing.TB) error {
+	status := c.Client(tb).Status()
+	deadline := time.Now().Add(leaderTimeout)
+	for time.Now().Before(deadline) {
+		if leader, err := status.Leader(); err == nil && len(leader) > 0 {
+			return nil
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return errNoLeader
+}
+
+// publishedAddress returns the host address docker published the HTTP API on.
+// docker may list an address for each IP family, in which case the first is used.
+func publishedAddress(id string) (string, error) {
+	out, err := docker("port", id, httpPort)
+	if err != nil {
+		return "", err
+	}
+
+	address, _, _ := strings.Cut(out, "\n")
+	if len(address) == 0 {
+		return "", fmt.Errorf("no published address for %s", httpPort)
+	}
+
+	return address, nil
+}
+
+// docker runs a docker command and returns its trimmed standard output.
+func docker(args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("docker", args...)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("docker %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
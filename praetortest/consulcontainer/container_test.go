@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+
+package consulcontainer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+)
+
+type ContainerSuite struct {
+	suite.Suite
+
+	container *Container
+	client    *api.Client
+}
+
+func (suite *ContainerSuite) SetupSuite() {
+	suite.container = Start(suite.T(), "")
+	suite.client = suite.container.Client(suite.T())
+}
+
+func (suite *ContainerSuite) TestConfig() {
+	cfg := suite.container.Config()
+	suite.Equal("http", cfg.Scheme)
+	suite.Equal(suite.container.Address(), cfg.Address)
+}
+
+// passing returns the IDs of the passing instances of a service.
+func (suite *ContainerSuite) passing(service string) (ids []string) {
+	entries, _, err := suite.client.Health().Service(service, "", true, nil)
+	suite.Require().NoError(err)
+	for _, e := range entries {
+		ids = append(ids, e.Service.ID)
+	}
+
+	return
+}
+
+func (suite *ContainerSuite) TestRegistrationAndDiscovery() {
+	agent := suite.client.Agent()
+	suite.Require().NoError(
+		agent.ServiceRegister(&api.AgentServiceRegistration{
+			ID:   "web-1",
+			Name: "web",
+			Port: 8080,
+			Check: &api.AgentServiceCheck{
+				CheckID: "web-1-ttl",
+				TTL:     "30s",
+			},
+		}),
+	)
+
+	defer agent.ServiceDeregister("web-1")
+
+	// a TTL check starts out critical, so the instance is not yet discoverable
+	suite.Empty(suite.passing("web"))
+
+	// check state reaches the catalog through anti-entropy, so discovery lags the update
+	suite.Require().NoError(agent.UpdateTTL("web-1-ttl", "ok", api.HealthPassing))
+	suite.Eventually(
+		func() bool {
+			ids := suite.passing("web")
+			return len(ids) == 1 && ids[0] == "web-1"
+		},
+		10*time.Second,
+		100*time.Millisecond,
+	)
+}
+
+func TestContainer(t *testing.T) {
+	suite.Run(t, new(ContainerSuite))
+}
@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Package consulcontainer runs a real consul agent in a container for integration
+tests. Where praetortest fakes the consul HTTP API in process, this package starts
+a dev mode agent, so tests can exercise registration, TTL checks, and discovery
+against consul itself.
+
+Containers are managed through the docker command line, so this package adds no
+dependencies to praetor. Start skips the calling test when docker is not installed.
+This package's own tests only run with the integration build tag:
+
+	go test -tags integration ./praetortest/consulcontainer/...
+*/
+package consulcontainer
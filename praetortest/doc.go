@@ -0,0 +1,14 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Package praetortest provides an in-process fake of the consul HTTP API for
+tests. The fake implements the subset of consul used by praetor and by typical
+applications built on it: the KV store, sessions, agent service registration and
+TTL check updates, and health service queries.
+
+A Server records what it receives, so tests can make assertions about the
+registrations and check updates an application sent without a real consul agent.
+Tests that need a real agent can use the consulcontainer package instead.
+*/
+package praetortest
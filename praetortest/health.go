@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetortest
+
+import (
+	"net/http"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/consul/api"
+)
+
+func (s *Server) healthRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/health/service/{service}", s.healthService)
+}
+
+// serviceEntry builds the health view of a registered service. The lock must be held.
+func (s *Server) serviceEntry(reg *api.AgentServiceRegistration) *api.ServiceEntry {
+	entry := &api.ServiceEntry{
+		Node: &api.Node{
+			Node:       NodeName,
+			Address:    NodeAddress,
+			Datacenter: Datacenter,
+		},
+		Service: agentService(reg),
+	}
+
+	for _, check := range s.checks {
+		if check.ServiceID == reg.ID {
+			entry.Checks = append(entry.Checks, &api.HealthCheck{
+				Node:        check.Node,
+				CheckID:     check.CheckID,
+				Name:        check.Name,
+				Status:      check.Status,
+				Notes:       check.Notes,
+				Output:      check.Output,
+				ServiceID:   check.ServiceID,
+				ServiceName: check.ServiceName,
+				ServiceTags: reg.Tags,
+				Type:        check.Type,
+			})
+		}
+	}
+
+	sort.Slice(entry.Checks, func(i, j int) bool { return entry.Checks[i].CheckID < entry.Checks[j].CheckID })
+	return entry
+}
+
+// hasTags tests if a service has every one of the given tags.
+func hasTags(reg *api.AgentServiceRegistration, tags []string) bool {
+	for _, tag := range tags {
+		if !slices.Contains(reg.Tags, tag) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *Server) healthService(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.block(r)
+
+	var (
+		service    = r.PathValue("service")
+		query      = r.URL.Query()
+		tags       = query["tag"]
+		_, passing = query[api.HealthPassing]
+		entries    = []*api.ServiceEntry{}
+	)
+
+	for _, reg := range s.services {
+		if reg.Name != service || !hasTags(reg, tags) {
+			continue
+		}
+
+		entry := s.serviceEntry(reg)
+		if passing && entry.Checks.AggregatedStatus() != api.HealthPassing {
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Service.ID < entries[j].Service.ID })
+	s.writeQueryResponse(w, http.StatusOK, entries)
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetortest
+
+import (
+	"testing"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+)
+
+type HealthSuite struct {
+	suite.Suite
+
+	server *Server
+	agent  *api.Agent
+	health *api.Health
+}
+
+func (suite *HealthSuite) SetupTest() {
+	suite.server = NewServer(suite.T())
+	client := suite.server.Client(suite.T())
+	suite.agent = client.Agent()
+	suite.health = client.Health()
+
+	for _, reg := range []*api.AgentServiceRegistration{
+		{ID: "web-1", Name: "web", Tags: []string{"blue", "edge"}, Check: &api.AgentServiceCheck{TTL: "10s", Status: api.HealthPassing}},
+		{ID: "web-2", Name: "web", Tags: []string{"green"}, Check: &api.AgentServiceCheck{TTL: "10s"}},
+		{ID: "web-3", Name: "web", Tags: []string{"blue"}, Weights: &api.AgentWeights{Passing: 5, Warning: 2}},
+		{ID: "db-1", Name: "db"},
+	} {
+		suite.Require().NoError(suite.agent.ServiceRegister(reg))
+	}
+}
+
+func (suite *HealthSuite) serviceIDs(entries []*api.ServiceEntry) (ids []string) {
+	for _, e := range entries {
+		ids = append(ids, e.Service.ID)
+	}
+
+	return
+}
+
+func (suite *HealthSuite) TestService() {
+	entries, meta, err := suite.health.Service("web", "", false, nil)
+	suite.Require().NoError(err)
+	suite.NotZero(meta.LastIndex)
+	suite.Equal([]string{"web-1", "web-2", "web-3"}, suite.serviceIDs(entries))
+
+	first := entries[0]
+	suite.Equal(NodeName, first.Node.Node)
+	suite.Equal(NodeAddress, first.Node.Address)
+	suite.Equal(Datacenter, first.Node.Datacenter)
+	suite.Equal("web", first.Service.Service)
+	suite.Equal(api.AgentWeights{Passing: 1, Warning: 1}, first.Service.Weights)
+	suite.Require().Len(first.Checks, 1)
+	suite.Equal("service:web-1", first.Checks[0].CheckID)
+	suite.Equal([]string{"blue", "edge"}, first.Checks[0].ServiceTags)
+
+	suite.Equal(api.AgentWeights{Passing: 5, Warning: 2}, entries[2].Service.Weights)
+}
+
+func (suite *HealthSuite) TestServiceTags() {
+	entries, _, err := suite.health.ServiceMultipleTags("web", []string{"blue"}, false, nil)
+	suite.Require().NoError(err)
+	suite.Equal([]string{"web-1", "web-3"}, suite.serviceIDs(entries))
+
+	entries, _, err = suite.health.ServiceMultipleTags("web", []string{"blue", "edge"}, false, nil)
+	suite.Require().NoError(err)
+	suite.Equal([]string{"web-1"}, suite.serviceIDs(entries))
+}
+
+func (suite *HealthSuite) TestServicePassing() {
+	entries, _, err := suite.health.Service("web", "", true, nil)
+	suite.Require().NoError(err)
+
+	// a service with no checks is considered passing
+	suite.Equal([]string{"web-1", "web-3"}, suite.serviceIDs(entries))
+
+	suite.Require().NoError(suite.agent.UpdateTTL("service:web-2", "", api.HealthPassing))
+	suite.Require().NoError(suite.agent.UpdateTTL("service:web-1", "", api.HealthWarning))
+	entries, _, err = suite.health.Service("web", "", true, nil)
+	suite.Require().NoError(err)
+	suite.Equal([]string{"web-2", "web-3"}, suite.serviceIDs(entries))
+}
+
+func (suite *HealthSuite) TestServiceUnknown() {
+	entries, _, err := suite.health.Service("nosuchservice", "", false, nil)
+	suite.Require().NoError(err)
+	suite.Empty(entries)
+}
+
+func TestHealth(t *testing.T) {
+	suite.Run(t, new(HealthSuite))
+}
@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetortest
+
+import (
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/consul/api"
+)
+
+func (s *Server) kvRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/kv/{key...}", s.kvGet)
+	mux.HandleFunc("PUT /v1/kv/{key...}", s.kvPut)
+	mux.HandleFunc("DELETE /v1/kv/{key...}", s.kvDelete)
+}
+
+// SetKV writes a raw value directly to this server's KV store.
+func (s *Server) SetKV(key string, value []byte) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.putKV(key, value, 0)
+}
+
+// DeleteKV removes a key directly from this server's KV store.
+func (s *Server) DeleteKV(key string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.kv, key)
+	s.bump()
+}
+
+// KV returns a copy of the pair stored at key, or nil if no such key exists.
+func (s *Server) KV(key string) *api.KVPair {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if p, ok := s.kv[key]; ok {
+		clone := *p
+		return &clone
+	}
+
+	return nil
+}
+
+// putKV stores a value. The lock must be held.
+func (s *Server) putKV(key string, value []byte, flags uint64) *api.KVPair {
+	index := s.bump()
+	p, ok := s.kv[key]
+	if !ok {
+		p = &api.KVPair{Key: key, CreateIndex: index}
+		s.kv[key] = p
+	}
+
+	p.Value = append([]byte(nil), value...)
+	p.Flags = flags
+	p.ModifyIndex = index
+	return p
+}
+
+func (s *Server) kvGet(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.block(r)
+
+	key := r.PathValue("key")
+	var pairs api.KVPairs
+	if _, recurse := r.URL.Query()["recurse"]; recurse {
+		for k, p := range s.kv {
+			if strings.HasPrefix(k, key) {
+				pairs = append(pairs, p)
+			}
+		}
+
+		sort.Slice(pairs, func(i, j int) bool { return pairs[i].Key < pairs[j].Key })
+	} else if p, ok := s.kv[key]; ok {
+		pairs = append(pairs, p)
+	}
+
+	if len(pairs) == 0 {
+		s.writeQueryResponse(w, http.StatusNotFound, nil)
+	} else {
+		s.writeQueryResponse(w, http.StatusOK, pairs)
+	}
+}
+
+func (s *Server) kvPut(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var (
+		key              = r.PathValue("key")
+		query            = r.URL.Query()
+		_, isCAS         = query["cas"]
+		cas, _           = strconv.ParseUint(query.Get("cas"), 10, 64)
+		flags, _         = strconv.ParseUint(query.Get("flags"), 10, 64)
+		acquire, release = query.Get("acquire"), query.Get("release")
+		existing         = s.kv[key]
+		body, _          = io.ReadAll(r.Body)
+		ok               = true
+	)
+
+	switch {
+	case isCAS && cas == 0:
+		ok = existing == nil
+
+	case isCAS:
+		ok = existing != nil && existing.ModifyIndex == cas
+
+	case len(acquire) > 0:
+		_, validSession := s.sessions[acquire]
+		ok = validSession && (existing == nil || existing.Session == "" || existing.Session == acquire)
+
+	case len(release) > 0:
+		ok = existing != nil && existing.Session == release
+	}
+
+	if ok {
+		p := s.putKV(key, body, flags)
+		switch {
+		case len(acquire) > 0 && p.Session != acquire:
+			p.Session = acquire
+			p.LockIndex++
+
+		case len(release) > 0:
+			p.Session = ""
+		}
+	}
+
+	writeJSON(w, http.StatusOK, ok)
+}
+
+func (s *Server) kvDelete(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var (
+		key      = r.PathValue("key")
+		query    = r.URL.Query()
+		_, isCAS = query["cas"]
+		cas, _   = strconv.ParseUint(query.Get("cas"), 10, 64)
+		existing = s.kv[key]
+		ok       = !isCAS || (existing != nil && existing.ModifyIndex == cas)
+	)
+
+	if ok {
+		if _, recurse := query["recurse"]; recurse {
+			for k := range s.kv {
+				if strings.HasPrefix(k, key) {
+					delete(s.kv, k)
+				}
+			}
+		} else {
+			delete(s.kv, key)
+		}
+
+		s.bump()
+	}
+
+	writeJSON(w, http.StatusOK, ok)
+}
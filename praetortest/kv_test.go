@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetortest
+
+import (
+	"testing"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+)
+
+type KVSuite struct {
+	suite.Suite
+
+	server *Server
+	kv     *api.KV
+}
+
+func (suite *KVSuite) SetupTest() {
+	suite.server = NewServer(suite.T())
+	suite.kv = suite.server.Client(suite.T()).KV()
+}
+
+func (suite *KVSuite) TestGetPut() {
+	pair, _, err := suite.kv.Get("key", nil)
+	suite.NoError(err)
+	suite.Nil(pair)
+	suite.Nil(suite.server.KV("key"))
+
+	_, err = suite.kv.Put(&api.KVPair{Key: "key", Value: []byte("value"), Flags: 12}, nil)
+	suite.Require().NoError(err)
+
+	pair, _, err = suite.kv.Get("key", nil)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(pair)
+	suite.Equal("value", string(pair.Value))
+	suite.Equal(uint64(12), pair.Flags)
+	suite.Equal(pair, suite.server.KV("key"))
+}
+
+func (suite *KVSuite) TestList() {
+	suite.server.SetKV("prefix/b", []byte("b"))
+	suite.server.SetKV("prefix/a", []byte("a"))
+	suite.server.SetKV("other", []byte("other"))
+
+	pairs, _, err := suite.kv.List("prefix/", nil)
+	suite.Require().NoError(err)
+	suite.Require().Len(pairs, 2)
+	suite.Equal("prefix/a", pairs[0].Key)
+	suite.Equal("prefix/b", pairs[1].Key)
+}
+
+func (suite *KVSuite) TestCAS() {
+	written, _, err := suite.kv.CAS(&api.KVPair{Key: "key", Value: []byte("first")}, nil)
+	suite.Require().NoError(err)
+	suite.True(written)
+
+	written, _, err = suite.kv.CAS(&api.KVPair{Key: "key", Value: []byte("second")}, nil)
+	suite.Require().NoError(err)
+	suite.False(written)
+
+	pair := suite.server.KV("key")
+	written, _, err = suite.kv.CAS(&api.KVPair{Key: "key", Value: []byte("third"), ModifyIndex: pair.ModifyIndex}, nil)
+	suite.Require().NoError(err)
+	suite.True(written)
+	suite.Equal("third", string(suite.server.KV("key").Value))
+}
+
+func (suite *KVSuite) TestDelete() {
+	suite.server.SetKV("a/1", []byte("1"))
+	suite.server.SetKV("a/2", []byte("2"))
+	suite.server.SetKV("b", []byte("b"))
+
+	deleted, _, err := suite.kv.DeleteCAS(&api.KVPair{Key: "b", ModifyIndex: 1}, nil)
+	suite.Require().NoError(err)
+	suite.False(deleted)
+
+	_, err = suite.kv.Delete("b", nil)
+	suite.Require().NoError(err)
+	suite.Nil(suite.server.KV("b"))
+
+	_, err = suite.kv.DeleteTree("a/", nil)
+	suite.Require().NoError(err)
+	suite.Nil(suite.server.KV("a/1"))
+	suite.Nil(suite.server.KV("a/2"))
+
+	suite.server.SetKV("c", nil)
+	suite.server.DeleteKV("c")
+	suite.Nil(suite.server.KV("c"))
+}
+
+func (suite *KVSuite) TestAcquireRelease() {
+	session, _, err := suite.server.Client(suite.T()).Session().Create(nil, nil)
+	suite.Require().NoError(err)
+
+	acquired, _, err := suite.kv.Acquire(&api.KVPair{Key: "lock", Session: "nosuchsession"}, nil)
+	suite.Require().NoError(err)
+	suite.False(acquired)
+
+	acquired, _, err = suite.kv.Acquire(&api.KVPair{Key: "lock", Session: session}, nil)
+	suite.Require().NoError(err)
+	suite.True(acquired)
+	suite.Equal(session, suite.server.KV("lock").Session)
+	suite.Equal(uint64(1), suite.server.KV("lock").LockIndex)
+
+	released, _, err := suite.kv.Release(&api.KVPair{Key: "lock", Session: "nosuchsession"}, nil)
+	suite.Require().NoError(err)
+	suite.False(released)
+
+	released, _, err = suite.kv.Release(&api.KVPair{Key: "lock", Session: session}, nil)
+	suite.Require().NoError(err)
+	suite.True(released)
+	suite.Empty(suite.server.KV("lock").Session)
+}
+
+func TestKV(t *testing.T) {
+	suite.Run(t, new(KVSuite))
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetortest
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/consul/api"
+)
+
+// LeaderAddress is the raft address of the leader that a Server reports by default.
+const LeaderAddress = NodeAddress + ":8300"
+
+func (s *Server) operatorRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /v1/operator/autopilot/health", s.operatorAutopilotHealth)
+	mux.HandleFunc("GET /v1/status/leader", s.statusLeader)
+}
+
+// SetLeader changes the raft leader this server reports. An empty leader simulates
+// a cluster that has lost its leader.
+func (s *Server) SetLeader(leader string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.leader = leader
+}
+
+// SetClusterHealthy changes whether autopilot reports the cluster as healthy.
+// A Server's cluster is healthy by default.
+func (s *Server) SetClusterHealthy(healthy bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.clusterUnhealthy = !healthy
+}
+
+// operatorAutopilotHealth reports a single server cluster. As with consul, an
+// unhealthy cluster results in a 429 response.
+func (s *Server) operatorAutopilotHealth(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var (
+		healthy = !s.clusterUnhealthy
+		status  = http.StatusOK
+		reply   = api.OperatorHealthReply{
+			Healthy: healthy,
+			Servers: []api.ServerHealth{
+				{
+					ID:      NodeName,
+					Name:    NodeName,
+					Address: LeaderAddress,
+					Leader:  len(s.leader) > 0,
+					Healthy: healthy,
+					Voter:   true,
+				},
+			},
+		}
+	)
+
+	if !healthy {
+		status = http.StatusTooManyRequests
+	}
+
+	writeJSON(w, status, reply)
+}
+
+func (s *Server) statusLeader(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	writeJSON(w, http.StatusOK, s.leader)
+}
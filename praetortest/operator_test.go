@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetortest
+
+import (
+	"testing"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+)
+
+type OperatorSuite struct {
+	suite.Suite
+
+	server *Server
+	client *api.Client
+}
+
+func (suite *OperatorSuite) SetupTest() {
+	suite.server = NewServer(suite.T())
+	suite.client = suite.server.Client(suite.T())
+}
+
+func (suite *OperatorSuite) TestAutopilotHealth() {
+	reply, err := suite.client.Operator().AutopilotServerHealth(nil)
+	suite.Require().NoError(err)
+	suite.True(reply.Healthy)
+	suite.Require().Len(reply.Servers, 1)
+	suite.True(reply.Servers[0].Leader)
+
+	suite.server.SetClusterHealthy(false)
+	reply, err = suite.client.Operator().AutopilotServerHealth(nil)
+	suite.Require().NoError(err)
+	suite.False(reply.Healthy)
+}
+
+func (suite *OperatorSuite) TestLeader() {
+	leader, err := suite.client.Status().Leader()
+	suite.Require().NoError(err)
+	suite.Equal(LeaderAddress, leader)
+
+	suite.server.SetLeader("")
+	leader, err = suite.client.Status().Leader()
+	suite.Require().NoError(err)
+	suite.Empty(leader)
+}
+
+func TestOperator(t *testing.T) {
+	suite.Run(t, new(OperatorSuite))
+}
@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetortest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+)
+
+const (
+	// Datacenter is the datacenter reported by a Server.
+	Datacenter = "dc1"
+
+	// NodeName is the name of the single node that a Server simulates.
+	NodeName = "praetortest"
+
+	// NodeAddress is the address of the single node that a Server simulates.
+	NodeAddress = "127.0.0.1"
+
+	// Version is the consul version reported by a Server.
+	Version = "1.20.0"
+
+	// DefaultWaitTime is the time a blocking query waits when the client
+	// doesn't specify a wait time.
+	DefaultWaitTime = time.Second
+)
+
+// Server is a fake consul agent backed by an httptest.Server. All state is held
+// in memory. A Server is safe for concurrent use.
+type Server struct {
+	server *httptest.Server
+
+	lock    sync.Mutex
+	changed chan struct{}
+	index   uint64
+
+	kv            map[string]*api.KVPair
+	sessions      map[string]*api.SessionEntry
+	nextSessionID int
+
+	services        map[string]*api.AgentServiceRegistration
+	checks          map[string]*api.AgentCheck
+	registrations   []RegisterRequest
+	deregistrations []string
+	checkUpdates    map[string][]CheckUpdate
+
+	authMethods map[string]authMethod
+	loginTokens map[string]*api.ACLToken
+	nextTokenID int
+
+	leafCerts       map[string]*api.LeafCert
+	intentions      map[intention]bool
+	intentionChecks int
+
+	catalog catalog
+
+	leader           string
+	clusterUnhealthy bool
+
+	unavailable bool
+	enforceACLs bool
+}
+
+// NewServer starts a Server. The server is closed when the given test completes.
+func NewServer(tb testing.TB) *Server {
+	s := &Server{
+		changed:      make(chan struct{}),
+		index:        1,
+		kv:           make(map[string]*api.KVPair),
+		sessions:     make(map[string]*api.SessionEntry),
+		services:     make(map[string]*api.AgentServiceRegistration),
+		checks:       make(map[string]*api.AgentCheck),
+		checkUpdates: make(map[string][]CheckUpdate),
+		authMethods:  make(map[string]authMethod),
+		loginTokens:  make(map[string]*api.ACLToken),
+		leafCerts:    make(map[string]*api.LeafCert),
+		intentions:   make(map[intention]bool),
+		catalog:      newCatalog(),
+		leader:       LeaderAddress,
+	}
+
+	mux := http.NewServeMux()
+	s.kvRoutes(mux)
+	s.sessionRoutes(mux)
+	s.agentRoutes(mux)
+	s.healthRoutes(mux)
+	s.catalogRoutes(mux)
+	s.aclRoutes(mux)
+	s.connectRoutes(mux)
+	s.txnRoutes(mux)
+	s.operatorRoutes(mux)
+
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.isUnavailable() {
+			http.Error(w, "consul unavailable", http.StatusServiceUnavailable)
+			return
+		}
+
+		if !s.authorized(r) {
+			http.Error(w, "Permission denied", http.StatusForbidden)
+			return
+		}
+
+		mux.ServeHTTP(w, r)
+	}))
+
+	tb.Cleanup(s.server.Close)
+	return s
+}
+
+// SetUnavailable controls whether this server answers every request with a 503.
+// Unlike Close, this can be undone, which is useful for simulating a transient
+// consul outage. Blocking queries already in progress are not interrupted.
+func (s *Server) SetUnavailable(unavailable bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.unavailable = unavailable
+}
+
+func (s *Server) isUnavailable() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.unavailable
+}
+
+// Close shuts down this server. Subsequent requests from clients will fail,
+// which is useful for simulating an unreachable consul. This method is idempotent.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Address returns the host:port of this server, suitable for api.Config.Address.
+func (s *Server) Address() string {
+	return strings.TrimPrefix(s.server.URL, "http://")
+}
+
+// Config returns a consul client configuration that talks to this server.
+func (s *Server) Config() api.Config {
+	return api.Config{
+		Scheme:     "http",
+		Address:    s.Address(),
+		Datacenter: Datacenter,
+	}
+}
+
+// Client creates a consul client that talks to this server. Any error fails the test.
+func (s *Server) Client(tb testing.TB) *api.Client {
+	cfg := s.Config()
+	c, err := api.NewClient(&cfg)
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	return c
+}
+
+// bump increments the index and wakes any blocking queries. The lock must be held.
+func (s *Server) bump() uint64 {
+	s.index++
+	close(s.changed)
+	s.changed = make(chan struct{})
+	return s.index
+}
+
+// block implements consul blocking query semantics for the index and wait
+// request parameters. The lock must be held on entry and is held on return.
+func (s *Server) block(r *http.Request) {
+	index, _ := strconv.ParseUint(r.URL.Query().Get("index"), 10, 64)
+	if index == 0 {
+		return
+	}
+
+	wait, _ := time.ParseDuration(r.URL.Query().Get("wait"))
+	if wait <= 0 {
+		wait = DefaultWaitTime
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	for s.index <= index {
+		changed := s.changed
+		s.lock.Unlock()
+
+		expired := false
+		select {
+		case <-changed:
+		case <-timer.C:
+			expired = true
+		case <-r.Context().Done():
+			expired = true
+		}
+
+		s.lock.Lock()
+		if expired {
+			return
+		}
+	}
+}
+
+// writeQueryResponse writes v as JSON along with the headers consul clients
+// expect from a read endpoint. The lock must be held.
+func (s *Server) writeQueryResponse(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("X-Consul-Index", strconv.FormatUint(s.index, 10))
+	w.Header().Set("X-Consul-LastContact", "0")
+	w.Header().Set("X-Consul-KnownLeader", "true")
+	writeJSON(w, status, v)
+}
+
+// writeJSON writes v as a JSON response. A nil v results in an empty body.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if v != nil {
+		json.NewEncoder(w).Encode(v)
+	}
+}
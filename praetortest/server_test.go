@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetortest
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServerSuite struct {
+	suite.Suite
+}
+
+func (suite *ServerSuite) TestConfig() {
+	s := NewServer(suite.T())
+	cfg := s.Config()
+	suite.Equal("http", cfg.Scheme)
+	suite.Equal(s.Address(), cfg.Address)
+	suite.Equal(Datacenter, cfg.Datacenter)
+	suite.False(strings.HasPrefix(s.Address(), "http"))
+}
+
+func (suite *ServerSuite) TestClose() {
+	s := NewServer(suite.T())
+	kv := s.Client(suite.T()).KV()
+
+	_, _, err := kv.Get("key", nil)
+	suite.NoError(err)
+
+	s.Close()
+	s.Close() // idempotent
+	_, _, err = kv.Get("key", nil)
+	suite.Error(err)
+}
+
+func (suite *ServerSuite) TestSetUnavailable() {
+	s := NewServer(suite.T())
+	kv := s.Client(suite.T()).KV()
+
+	s.SetUnavailable(true)
+	_, _, err := kv.Get("key", nil)
+	suite.ErrorContains(err, "503")
+
+	s.SetUnavailable(false)
+	_, _, err = kv.Get("key", nil)
+	suite.NoError(err)
+}
+
+func (suite *ServerSuite) TestBlockingQuery() {
+	s := NewServer(suite.T())
+	kv := s.Client(suite.T()).KV()
+	s.SetKV("key", []byte("first"))
+
+	_, meta, err := kv.Get("key", nil)
+	suite.Require().NoError(err)
+
+	// with no changes, the query should wait for the given time
+	start := time.Now()
+	_, timedOut, err := kv.Get("key", &api.QueryOptions{
+		WaitIndex: meta.LastIndex,
+		WaitTime:  50 * time.Millisecond,
+	})
+
+	suite.Require().NoError(err)
+	suite.GreaterOrEqual(time.Since(start), 50*time.Millisecond)
+	suite.Equal(meta.LastIndex, timedOut.LastIndex)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		s.SetKV("key", []byte("second"))
+	}()
+
+	pair, changed, err := kv.Get("key", &api.QueryOptions{
+		WaitIndex: meta.LastIndex,
+		WaitTime:  5 * time.Second,
+	})
+
+	suite.Require().NoError(err)
+	suite.Greater(changed.LastIndex, meta.LastIndex)
+	suite.Equal("second", string(pair.Value))
+}
+
+func TestServer(t *testing.T) {
+	suite.Run(t, new(ServerSuite))
+}
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetortest
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/consul/api"
+)
+
+func (s *Server) sessionRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("PUT /v1/session/create", s.sessionCreate)
+	mux.HandleFunc("PUT /v1/session/renew/{id}", s.sessionRenew)
+	mux.HandleFunc("PUT /v1/session/destroy/{id}", s.sessionDestroy)
+	mux.HandleFunc("GET /v1/session/info/{id}", s.sessionInfo)
+	mux.HandleFunc("GET /v1/session/list", s.sessionList)
+}
+
+// SessionIDs returns the sorted IDs of all current sessions.
+func (s *Server) SessionIDs() (ids []string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for id := range s.sessions {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+	return
+}
+
+// InvalidateSession simulates consul invalidating a session, e.g. due to a
+// missed renewal. Keys held by the session are released.
+func (s *Server) InvalidateSession(id string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.destroySession(id)
+}
+
+// destroySession removes a session and releases any keys it holds. The lock must be held.
+func (s *Server) destroySession(id string) bool {
+	if _, ok := s.sessions[id]; !ok {
+		return false
+	}
+
+	delete(s.sessions, id)
+	index := s.bump()
+	for _, p := range s.kv {
+		if p.Session == id {
+			p.Session = ""
+			p.ModifyIndex = index
+		}
+	}
+
+	return true
+}
+
+func (s *Server) sessionCreate(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var entry api.SessionEntry
+	if err := json.NewDecoder(r.Body).Decode(&entry); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.nextSessionID++
+	entry.ID = "session-" + strconv.Itoa(s.nextSessionID)
+	entry.Node = NodeName
+	entry.CreateIndex = s.bump()
+	s.sessions[entry.ID] = &entry
+	writeJSON(w, http.StatusOK, map[string]string{"ID": entry.ID})
+}
+
+func (s *Server) sessionRenew(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if entry, ok := s.sessions[r.PathValue("id")]; ok {
+		s.writeQueryResponse(w, http.StatusOK, []*api.SessionEntry{entry})
+	} else {
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (s *Server) sessionDestroy(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	writeJSON(w, http.StatusOK, s.destroySession(r.PathValue("id")))
+}
+
+func (s *Server) sessionInfo(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.block(r)
+
+	entries := []*api.SessionEntry{}
+	if entry, ok := s.sessions[r.PathValue("id")]; ok {
+		entries = append(entries, entry)
+	}
+
+	s.writeQueryResponse(w, http.StatusOK, entries)
+}
+
+func (s *Server) sessionList(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.block(r)
+
+	entries := []*api.SessionEntry{}
+	for _, entry := range s.sessions {
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].ID < entries[j].ID })
+	s.writeQueryResponse(w, http.StatusOK, entries)
+}
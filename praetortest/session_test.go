@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetortest
+
+import (
+	"testing"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+)
+
+type SessionSuite struct {
+	suite.Suite
+
+	server  *Server
+	client  *api.Client
+	session *api.Session
+}
+
+func (suite *SessionSuite) SetupTest() {
+	suite.server = NewServer(suite.T())
+	suite.client = suite.server.Client(suite.T())
+	suite.session = suite.client.Session()
+}
+
+func (suite *SessionSuite) TestLifecycle() {
+	id, _, err := suite.session.Create(&api.SessionEntry{Name: "test", TTL: "10s"}, nil)
+	suite.Require().NoError(err)
+	suite.NotEmpty(id)
+	suite.Equal([]string{id}, suite.server.SessionIDs())
+
+	entry, _, err := suite.session.Info(id, nil)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(entry)
+	suite.Equal("test", entry.Name)
+	suite.Equal(NodeName, entry.Node)
+
+	entries, _, err := suite.session.List(nil)
+	suite.Require().NoError(err)
+	suite.Len(entries, 1)
+
+	entry, _, err = suite.session.Renew(id, nil)
+	suite.Require().NoError(err)
+	suite.NotNil(entry)
+
+	_, err = suite.session.Destroy(id, nil)
+	suite.Require().NoError(err)
+	suite.Empty(suite.server.SessionIDs())
+
+	entry, _, err = suite.session.Renew(id, nil)
+	suite.NoError(err)
+	suite.Nil(entry)
+
+	entry, _, err = suite.session.Info(id, nil)
+	suite.NoError(err)
+	suite.Nil(entry)
+}
+
+func (suite *SessionSuite) TestInvalidateSession() {
+	id, _, err := suite.session.Create(nil, nil)
+	suite.Require().NoError(err)
+
+	acquired, _, err := suite.client.KV().Acquire(&api.KVPair{Key: "lock", Session: id}, nil)
+	suite.Require().NoError(err)
+	suite.Require().True(acquired)
+	before := suite.server.KV("lock")
+
+	suite.server.InvalidateSession(id)
+	suite.Empty(suite.server.SessionIDs())
+
+	after := suite.server.KV("lock")
+	suite.Empty(after.Session)
+	suite.Greater(after.ModifyIndex, before.ModifyIndex)
+}
+
+func TestSession(t *testing.T) {
+	suite.Run(t, new(SessionSuite))
+}
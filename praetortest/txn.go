@@ -0,0 +1,236 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetortest
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+
+	"github.com/hashicorp/consul/api"
+)
+
+// catalog holds the nodes, services, and checks registered through transactions.
+// These are separate from the local agent's services, as they are in consul when
+// registering external services.
+type catalog struct {
+	nodes    map[string]*api.Node
+	services map[string]map[string]*api.AgentService
+	checks   map[string]map[string]*api.HealthCheck
+}
+
+func newCatalog() catalog {
+	return catalog{
+		nodes:    make(map[string]*api.Node),
+		services: make(map[string]map[string]*api.AgentService),
+		checks:   make(map[string]map[string]*api.HealthCheck),
+	}
+}
+
+// clone creates a copy of this catalog that can be modified independently.
+func (c catalog) clone() catalog {
+	clone := catalog{
+		nodes:    maps.Clone(c.nodes),
+		services: maps.Clone(c.services),
+		checks:   maps.Clone(c.checks),
+	}
+
+	for node, services := range clone.services {
+		clone.services[node] = maps.Clone(services)
+	}
+
+	for node, checks := range clone.checks {
+		clone.checks[node] = maps.Clone(checks)
+	}
+
+	return clone
+}
+
+// apply performs a single transaction operation.
+func (c catalog) apply(op *api.TxnOp) (*api.TxnResult, error) {
+	switch {
+	case op.Node != nil:
+		return c.applyNode(op.Node)
+
+	case op.Service != nil:
+		return c.applyService(op.Service)
+
+	case op.Check != nil:
+		return c.applyCheck(op.Check)
+
+	default:
+		return nil, fmt.Errorf("unsupported transaction operation")
+	}
+}
+
+func (c catalog) applyNode(op *api.NodeTxnOp) (*api.TxnResult, error) {
+	name := op.Node.Node
+	switch op.Verb {
+	case api.NodeSet:
+		if len(name) == 0 || len(op.Node.Address) == 0 {
+			return nil, fmt.Errorf("a node name and address are required")
+		}
+
+		node := op.Node
+		c.nodes[name] = &node
+		return &api.TxnResult{Node: &node}, nil
+
+	case api.NodeDelete:
+		delete(c.nodes, name)
+		delete(c.services, name)
+		delete(c.checks, name)
+		return nil, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported node verb %q", op.Verb)
+	}
+}
+
+func (c catalog) applyService(op *api.ServiceTxnOp) (*api.TxnResult, error) {
+	if _, ok := c.nodes[op.Node]; !ok {
+		return nil, fmt.Errorf("node %q not found", op.Node)
+	}
+
+	switch op.Verb {
+	case api.ServiceSet:
+		service := op.Service
+		if len(service.ID) == 0 {
+			service.ID = service.Service
+		}
+
+		if c.services[op.Node] == nil {
+			c.services[op.Node] = make(map[string]*api.AgentService)
+		}
+
+		c.services[op.Node][service.ID] = &service
+		return &api.TxnResult{
+			Service: &api.CatalogService{
+				Node:           op.Node,
+				ServiceID:      service.ID,
+				ServiceName:    service.Service,
+				ServiceAddress: service.Address,
+				ServicePort:    service.Port,
+				ServiceTags:    service.Tags,
+			},
+		}, nil
+
+	case api.ServiceDelete:
+		delete(c.services[op.Node], op.Service.ID)
+		return nil, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported service verb %q", op.Verb)
+	}
+}
+
+func (c catalog) applyCheck(op *api.CheckTxnOp) (*api.TxnResult, error) {
+	node := op.Check.Node
+	if _, ok := c.nodes[node]; !ok {
+		return nil, fmt.Errorf("node %q not found", node)
+	}
+
+	switch op.Verb {
+	case api.CheckSet:
+		check := op.Check
+		if len(check.ServiceID) > 0 {
+			if _, ok := c.services[node][check.ServiceID]; !ok {
+				return nil, fmt.Errorf("service %q not found on node %q", check.ServiceID, node)
+			}
+		}
+
+		if c.checks[node] == nil {
+			c.checks[node] = make(map[string]*api.HealthCheck)
+		}
+
+		c.checks[node][check.CheckID] = &check
+		return &api.TxnResult{Check: &check}, nil
+
+	case api.CheckDelete:
+		delete(c.checks[node], op.Check.CheckID)
+		return nil, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported check verb %q", op.Verb)
+	}
+}
+
+func (s *Server) txnRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("PUT /v1/txn", s.txn)
+}
+
+// CatalogNode returns a node registered through a transaction.
+func (s *Server) CatalogNode(name string) (api.Node, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if node, ok := s.catalog.nodes[name]; ok {
+		return *node, true
+	}
+
+	return api.Node{}, false
+}
+
+// CatalogService returns a service registered on a node through a transaction.
+func (s *Server) CatalogService(node, serviceID string) (api.AgentService, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if service, ok := s.catalog.services[node][serviceID]; ok {
+		return *service, true
+	}
+
+	return api.AgentService{}, false
+}
+
+// CatalogCheck returns a check registered on a node through a transaction.
+func (s *Server) CatalogCheck(node, checkID string) (api.HealthCheck, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if check, ok := s.catalog.checks[node][checkID]; ok {
+		return *check, true
+	}
+
+	return api.HealthCheck{}, false
+}
+
+// txn applies node, service, and check operations atomically. If any operation
+// fails, none are applied and consul's conflict response is returned.
+func (s *Server) txn(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var ops api.TxnOps
+	if err := json.NewDecoder(r.Body).Decode(&ops); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var (
+		staged   = s.catalog.clone()
+		response api.TxnResponse
+	)
+
+	for i, op := range ops {
+		result, err := staged.apply(op)
+		switch {
+		case err != nil:
+			response.Errors = append(response.Errors, &api.TxnError{
+				OpIndex: i,
+				What:    err.Error(),
+			})
+
+		case result != nil:
+			response.Results = append(response.Results, result)
+		}
+	}
+
+	if len(response.Errors) > 0 {
+		response.Results = nil
+		s.writeQueryResponse(w, http.StatusConflict, response)
+		return
+	}
+
+	s.catalog = staged
+	s.bump()
+	s.writeQueryResponse(w, http.StatusOK, response)
+}
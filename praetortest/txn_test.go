@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetortest
+
+import (
+	"testing"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+)
+
+type TxnSuite struct {
+	suite.Suite
+
+	server *Server
+	txn    *api.Txn
+}
+
+func (suite *TxnSuite) SetupTest() {
+	suite.server = NewServer(suite.T())
+	suite.txn = suite.server.Client(suite.T()).Txn()
+}
+
+func (suite *TxnSuite) TestCommit() {
+	ok, resp, _, err := suite.txn.Txn(
+		api.TxnOps{
+			{Node: &api.NodeTxnOp{Verb: api.NodeSet, Node: api.Node{Node: "external", Address: "db.example.com"}}},
+			{Service: &api.ServiceTxnOp{Verb: api.ServiceSet, Node: "external", Service: api.AgentService{Service: "db", Port: 5432}}},
+			{Check: &api.CheckTxnOp{Verb: api.CheckSet, Check: api.HealthCheck{Node: "external", CheckID: "db", ServiceID: "db", Status: api.HealthPassing}}},
+		},
+		nil,
+	)
+
+	suite.Require().NoError(err)
+	suite.True(ok)
+	suite.Len(resp.Results, 3)
+	suite.Empty(resp.Errors)
+
+	node, ok := suite.server.CatalogNode("external")
+	suite.True(ok)
+	suite.Equal("db.example.com", node.Address)
+
+	service, ok := suite.server.CatalogService("external", "db")
+	suite.True(ok)
+	suite.Equal(5432, service.Port)
+
+	check, ok := suite.server.CatalogCheck("external", "db")
+	suite.True(ok)
+	suite.Equal(api.HealthPassing, check.Status)
+
+	ok, _, _, err = suite.txn.Txn(
+		api.TxnOps{
+			{Node: &api.NodeTxnOp{Verb: api.NodeDelete, Node: api.Node{Node: "external"}}},
+		},
+		nil,
+	)
+
+	suite.Require().NoError(err)
+	suite.True(ok)
+
+	_, ok = suite.server.CatalogNode("external")
+	suite.False(ok)
+
+	_, ok = suite.server.CatalogService("external", "db")
+	suite.False(ok)
+
+	_, ok = suite.server.CatalogCheck("external", "db")
+	suite.False(ok)
+}
+
+func (suite *TxnSuite) TestRollback() {
+	ok, resp, _, err := suite.txn.Txn(
+		api.TxnOps{
+			{Node: &api.NodeTxnOp{Verb: api.NodeSet, Node: api.Node{Node: "external", Address: "db.example.com"}}},
+			{Service: &api.ServiceTxnOp{Verb: api.ServiceSet, Node: "missing", Service: api.AgentService{Service: "db"}}},
+		},
+		nil,
+	)
+
+	suite.Require().NoError(err)
+	suite.False(ok)
+	suite.Require().Len(resp.Errors, 1)
+	suite.Equal(1, resp.Errors[0].OpIndex)
+
+	_, ok = suite.server.CatalogNode("external")
+	suite.False(ok)
+}
+
+func TestTxn(t *testing.T) {
+	suite.Run(t, new(TxnSuite))
+}
@@ -4,12 +4,45 @@
 package praetor
 
 import (
+	"context"
+
 	"github.com/hashicorp/consul/api"
 	"go.uber.org/fx"
 )
 
-func newClient(cfg api.Config) (*api.Client, error) {
-	return api.NewClient(&cfg)
+type clientIn struct {
+	fx.In
+
+	Config    api.Config
+	Login     LoginConfig `optional:"true"`
+	Lifecycle fx.Lifecycle
+}
+
+func newClient(in clientIn) (*api.Client, error) {
+	c, err := api.NewClient(&in.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	// api.NewClient fills in the HTTP client when the config doesn't supply one.
+	// Stop hooks run in reverse order, so this runs after any logout.
+	in.Lifecycle.Append(fx.StopHook(in.Config.HttpClient.CloseIdleConnections))
+
+	if len(in.Login.AuthMethod) > 0 {
+		// log in now, so that constructors which query consul, such as those
+		// for KVConfig and AgentInfo, are authorized when ACLs are enforced
+		l := NewLogin(c, in.Login)
+		ctx, cancel := context.WithTimeout(context.Background(), l.timeout)
+		err = l.Authenticate(ctx)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+
+		in.Lifecycle.Append(fx.StartStopHook(l.Start, l.Stop))
+	}
+
+	return c, nil
 }
 
 func newAgent(c *api.Client) *api.Agent {
@@ -24,6 +57,14 @@ func newHealth(c *api.Client) *api.Health {
 	return c.Health()
 }
 
+func newKV(c *api.Client) *api.KV {
+	return c.KV()
+}
+
+func newPeerings(c *api.Client) *api.Peerings {
+	return c.Peerings()
+}
+
 // Provide sets up the dependency injection infrastructure for Consul.
 // This provider expects an api.Config to be present in the application
 // (NOT an *api.Config). In order to bootstrap using praetor's cofiguration,
@@ -35,21 +76,76 @@ func newHealth(c *api.Client) *api.Health {
 //   - *api.Agent
 //   - *api.Catalog
 //   - *api.Health
+//   - *api.KV
+//   - *api.Peerings
+//   - *KVStore
+//   - *Nodes
+//
+// When the application stops, idle connections held by the consul client's
+// HTTP transport are closed.
 func Provide() fx.Option {
 	return fx.Provide(
 		newClient,
 		newAgent,
 		newCatalog,
 		newHealth,
+		newKV,
+		newPeerings,
+		NewKVStore,
+		NewNodes,
 	)
 }
 
-// ProvideConfig bootstraps an api.Config using a praetor Config.
+// ProvideConfig bootstraps an api.Config using a praetor Config. The Config's
+// LoginConfig is also emitted. When it names an auth method, the *api.Client
+// emitted by Provide logs in as soon as it is created, so that other constructors
+// can use it when ACLs are enforced, and logs out when the application stops.
+// That initial login is a blocking network call made while fx constructs the
+// application. It is bounded by LoginConfig.Timeout, and a failure prevents the
+// application from being created.
 //
 // NOTE: In order to inject a custom *http.Client or *http.Transport,
 // use fx.Decorate and decorate the api.Config.
 func ProvideConfig() fx.Option {
 	return fx.Provide(
 		NewAPIConfig,
+		func(src Config) LoginConfig {
+			return src.Login
+		},
+	)
+}
+
+// ProvideDefaultConfig is like ProvideConfig, except that the api.Config starts
+// from consul's defaults. This lets the standard CONSUL_* environment variables
+// supply anything the praetor Config leaves unset. See NewDefaultAPIConfig.
+func ProvideDefaultConfig() fx.Option {
+	return fx.Provide(
+		NewDefaultAPIConfig,
+		func(src Config) LoginConfig {
+			return src.Login
+		},
+	)
+}
+
+// ModuleName is the name of the fx.Module created by Module.
+const ModuleName = "praetor"
+
+// Module bundles ProvideConfig and Provide into a named fx.Module. Any additional
+// options are scoped to the module. In particular, decorators passed to this function
+// only affect praetor's components, which lets an application customize consul wiring
+// without affecting the rest of its dependency graph.
+//
+// Because this module includes ProvideConfig, the application must supply a praetor
+// Config. An application that supplies an api.Config directly should use Provide instead.
+func Module(opts ...fx.Option) fx.Option {
+	return fx.Module(
+		ModuleName,
+		append(
+			[]fx.Option{
+				ProvideConfig(),
+				Provide(),
+			},
+			opts...,
+		)...,
 	)
 }
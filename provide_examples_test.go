@@ -119,3 +119,30 @@ func ExampleProvide_injectcustomclient() {
 	// catalog
 	// health
 }
+
+func ExampleModule() {
+	fx.New(
+		fx.NopLogger,
+		fx.Supply(Config{
+			Scheme:  "https",
+			Address: "foobar:8080",
+		}),
+		Module(
+			// this decoration is only visible within praetor's module
+			fx.Decorate(
+				func(original api.Config) api.Config {
+					original.Datacenter = "dc1"
+					return original
+				},
+			),
+		),
+		fx.Invoke(
+			func(client *api.Client) {
+				fmt.Println("client")
+			},
+		),
+	)
+
+	// Output:
+	// client
+}
@@ -4,6 +4,7 @@
 package praetor
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/hashicorp/consul/api"
@@ -18,10 +19,14 @@ type ProvideSuite struct {
 
 func (suite *ProvideSuite) TestProvide() {
 	var (
-		client  *api.Client
-		agent   *api.Agent
-		catalog *api.Catalog
-		health  *api.Health
+		client   *api.Client
+		agent    *api.Agent
+		catalog  *api.Catalog
+		health   *api.Health
+		kv       *api.KV
+		peerings *api.Peerings
+		kvStore  *KVStore
+		nodes    *Nodes
 
 		app = fxtest.New(
 			suite.T(),
@@ -32,6 +37,10 @@ func (suite *ProvideSuite) TestProvide() {
 				&agent,
 				&catalog,
 				&health,
+				&kv,
+				&peerings,
+				&kvStore,
+				&nodes,
 			),
 		)
 	)
@@ -41,15 +50,56 @@ func (suite *ProvideSuite) TestProvide() {
 	suite.NotNil(agent)
 	suite.NotNil(catalog)
 	suite.NotNil(health)
+	suite.NotNil(kv)
+	suite.NotNil(peerings)
+	suite.NotNil(kvStore)
+	suite.NotNil(nodes)
+}
+
+// idleTransport records calls to CloseIdleConnections.
+type idleTransport struct {
+	http.RoundTripper
+	closed int
+}
+
+func (t *idleTransport) CloseIdleConnections() {
+	t.closed++
+}
+
+func (suite *ProvideSuite) TestCloseIdleConnectionsOnStop() {
+	var (
+		transport = &idleTransport{RoundTripper: http.DefaultTransport}
+		client    *api.Client
+
+		app = fxtest.New(
+			suite.T(),
+			fx.Supply(api.Config{
+				HttpClient: &http.Client{Transport: transport},
+			}),
+			Provide(),
+			fx.Populate(&client),
+		)
+	)
+
+	suite.Require().NotNil(client)
+	app.RequireStart()
+	suite.Zero(transport.closed)
+
+	app.RequireStop()
+	suite.Equal(1, transport.closed)
 }
 
 func (suite *ProvideSuite) TestProvideConfig() {
 	var (
-		config  api.Config
-		client  *api.Client
-		agent   *api.Agent
-		catalog *api.Catalog
-		health  *api.Health
+		config   api.Config
+		client   *api.Client
+		agent    *api.Agent
+		catalog  *api.Catalog
+		health   *api.Health
+		kv       *api.KV
+		peerings *api.Peerings
+		kvStore  *KVStore
+		nodes    *Nodes
 
 		app = fxtest.New(
 			suite.T(),
@@ -67,6 +117,10 @@ func (suite *ProvideSuite) TestProvideConfig() {
 				&agent,
 				&catalog,
 				&health,
+				&kv,
+				&peerings,
+				&kvStore,
+				&nodes,
 			),
 		)
 	)
@@ -78,6 +132,102 @@ func (suite *ProvideSuite) TestProvideConfig() {
 	suite.NotNil(agent)
 	suite.NotNil(catalog)
 	suite.NotNil(health)
+	suite.NotNil(kv)
+	suite.NotNil(peerings)
+	suite.NotNil(kvStore)
+	suite.NotNil(nodes)
+}
+
+func (suite *ProvideSuite) TestProvideDefaultConfig() {
+	suite.T().Setenv("CONSUL_HTTP_ADDR", "consul.example.com:8500")
+	suite.T().Setenv("CONSUL_HTTP_TOKEN", "env-token")
+
+	var (
+		config api.Config
+		login  LoginConfig
+
+		app = fxtest.New(
+			suite.T(),
+			fx.Supply(
+				Config{
+					Datacenter: "abc",
+				},
+			),
+			ProvideDefaultConfig(),
+			fx.Populate(&config, &login),
+		)
+	)
+
+	suite.NoError(app.Err())
+	suite.Equal("consul.example.com:8500", config.Address)
+	suite.Equal("env-token", config.Token)
+	suite.Equal("abc", config.Datacenter)
+	suite.Empty(login.AuthMethod)
+}
+
+func (suite *ProvideSuite) TestModule() {
+	var (
+		config api.Config
+		client *api.Client
+		kv     *KVStore
+
+		app = fxtest.New(
+			suite.T(),
+			fx.Supply(
+				Config{
+					Scheme:  "http",
+					Address: "foobar:8080",
+				},
+			),
+			Module(
+				fx.Decorate(
+					func(cfg Config) Config {
+						cfg.Datacenter = "decorated"
+						return cfg
+					},
+				),
+			),
+			fx.Populate(
+				&config,
+				&client,
+				&kv,
+			),
+		)
+	)
+
+	suite.NoError(app.Err())
+	suite.Equal("http", config.Scheme)
+	suite.Equal("foobar:8080", config.Address)
+	suite.Equal("decorated", config.Datacenter)
+	suite.NotNil(client)
+	suite.NotNil(kv)
+}
+
+func (suite *ProvideSuite) TestModuleDecorationIsScoped() {
+	var (
+		config Config
+
+		app = fxtest.New(
+			suite.T(),
+			fx.Supply(
+				Config{
+					Address: "foobar:8080",
+				},
+			),
+			Module(
+				fx.Decorate(
+					func(cfg Config) Config {
+						cfg.Address = "decorated:8080"
+						return cfg
+					},
+				),
+			),
+			fx.Populate(&config),
+		)
+	)
+
+	suite.NoError(app.Err())
+	suite.Equal("foobar:8080", config.Address)
 }
 
 func TestProvide(t *testing.T) {
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// runner manages the background goroutine of a component with Start and Stop methods.
+type runner struct {
+	lock   sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// start invokes initialize and runs the function it returns in a background goroutine.
+// The context passed to that function is canceled by stop. The runner is locked while
+// initialize executes, so concurrent starts are serialized. If initialize fails, its
+// error is returned and no goroutine is started. If this runner is already running,
+// ErrAlreadyStarted is returned and initialize is not invoked.
+func (r *runner) start(initialize func() (run func(context.Context), err error)) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.cancel != nil {
+		return ErrAlreadyStarted
+	}
+
+	run, err := initialize()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	r.cancel, r.done = cancel, done
+	go func() {
+		defer close(done)
+		run(ctx)
+	}()
+
+	return nil
+}
+
+// stop cancels the background goroutine started by start, waiting until it exits
+// or the given context is canceled. This method is idempotent.
+func (r *runner) stop(ctx context.Context) error {
+	r.lock.Lock()
+	cancel, done := r.cancel, r.done
+	r.cancel, r.done = nil, nil
+	r.lock.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+
+	cancel()
+	select {
+	case <-done:
+		return nil
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// poller queries at a fixed interval in a background goroutine. It is used for
+// consul state that has no blocking query.
+//
+// A poller always starts, even when its initial query fails. Components built on a
+// poller report consul's state, including its unavailability, so a failed query is
+// delivered to initial or next as an event rather than returned from start.
+type poller[E any] struct {
+	runner   runner
+	interval time.Duration
+}
+
+// start performs an initial query and passes its result to initial, then invokes query
+// every interval in a background goroutine and passes each result to next. A query
+// interrupted by stop is discarded. The only error returned is ErrAlreadyStarted.
+func (p *poller[E]) start(ctx context.Context, query func(context.Context) E, initial func(E), next func(E)) error {
+	return p.runner.start(func() (func(context.Context), error) {
+		initial(query(ctx))
+
+		return func(ctx context.Context) {
+			ticker := time.NewTicker(p.interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+
+				e := query(ctx)
+				if ctx.Err() != nil {
+					return
+				}
+
+				next(e)
+			}
+		}, nil
+	})
+}
+
+// stop halts the background goroutine. This method is idempotent.
+func (p *poller[E]) stop(ctx context.Context) error {
+	return p.runner.stop(ctx)
+}
+
+// watcher runs consul blocking queries in a background goroutine. It follows consul's
+// blocking query guidance: each query waits on the index returned by the previous one,
+// the index is reset when it goes backwards, and failed queries are retried after a delay.
+type watcher[E any] struct {
+	runner        runner
+	retryInterval time.Duration
+}
+
+// start performs an initial, non-blocking query. If that query fails, its error is
+// returned and the watcher is not started. Otherwise, its result is passed to dispatch
+// and blocking queries begin in a background goroutine.
+//
+// Each failed query is passed to dispatch and retried after retryInterval. A successful
+// result is passed to dispatch when changed reports that it differs from the last
+// dispatched result, and also after any failure so that listeners learn that consul
+// recovered. A query interrupted by stop is discarded.
+func (w *watcher[E]) start(ctx context.Context, query func(context.Context, uint64) (E, uint64, error), changed func(E) bool, dispatch func(E)) error {
+	return w.runner.start(func() (func(context.Context), error) {
+		initial, index, err := query(ctx, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		dispatch(initial)
+
+		return func(ctx context.Context) {
+			w.run(ctx, index, query, changed, dispatch)
+		}, nil
+	})
+}
+
+// run is the background goroutine that performs blocking queries.
+func (w *watcher[E]) run(ctx context.Context, index uint64, query func(context.Context, uint64) (E, uint64, error), changed func(E) bool, dispatch func(E)) {
+	failing := false
+	for {
+		next, nextIndex, err := query(ctx, index)
+		switch {
+		case ctx.Err() != nil:
+			return
+
+		case err != nil:
+			dispatch(next)
+			failing = true
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(w.retryInterval):
+			}
+
+		default:
+			if nextIndex < index {
+				index = 0
+			} else {
+				index = nextIndex
+			}
+
+			if failing || changed(next) {
+				dispatch(next)
+				failing = false
+			}
+		}
+	}
+}
+
+// stop halts the background goroutine. This method is idempotent.
+func (w *watcher[E]) stop(ctx context.Context) error {
+	return w.runner.stop(ctx)
+}
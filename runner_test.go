@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type RunnerSuite struct {
+	suite.Suite
+}
+
+func (suite *RunnerSuite) TestStartStop() {
+	var (
+		r       runner
+		running = make(chan struct{})
+		exited  = make(chan struct{})
+	)
+
+	suite.NoError(r.stop(context.Background()))
+	suite.Require().NoError(r.start(func() (func(context.Context), error) {
+		return func(ctx context.Context) {
+			close(running)
+			<-ctx.Done()
+			close(exited)
+		}, nil
+	}))
+
+	<-running
+	suite.ErrorIs(
+		r.start(func() (func(context.Context), error) {
+			suite.Fail("initialize should not be called while running")
+			return nil, nil
+		}),
+		ErrAlreadyStarted,
+	)
+
+	suite.NoError(r.stop(context.Background()))
+	select {
+	case <-exited:
+	default:
+		suite.Fail("stop did not wait for the goroutine")
+	}
+
+	suite.NoError(r.stop(context.Background()))
+}
+
+func (suite *RunnerSuite) TestInitializeError() {
+	var (
+		r           runner
+		expectedErr = errors.New("expected")
+	)
+
+	suite.ErrorIs(
+		r.start(func() (func(context.Context), error) {
+			return nil, expectedErr
+		}),
+		expectedErr,
+	)
+
+	// a failed start leaves the runner stopped, so it can be started again
+	suite.Require().NoError(r.start(func() (func(context.Context), error) {
+		return func(ctx context.Context) { <-ctx.Done() }, nil
+	}))
+
+	suite.NoError(r.stop(context.Background()))
+}
+
+func (suite *RunnerSuite) TestStopTimeout() {
+	var (
+		r       runner
+		release = make(chan struct{})
+	)
+
+	defer close(release)
+	suite.Require().NoError(r.start(func() (func(context.Context), error) {
+		return func(context.Context) { <-release }, nil
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	suite.ErrorIs(r.stop(ctx), context.Canceled)
+}
+
+func (suite *RunnerSuite) TestPoller() {
+	var (
+		p       = poller[int]{interval: 10 * time.Millisecond}
+		queries int
+		results = make(chan int, 10)
+	)
+
+	query := func(context.Context) int {
+		queries++
+		return queries
+	}
+
+	suite.Require().NoError(p.start(
+		context.Background(),
+		query,
+		func(v int) {
+			suite.Equal(1, v)
+		},
+		func(v int) {
+			results <- v
+		},
+	))
+
+	for expected := 2; expected < 4; expected++ {
+		select {
+		case v := <-results:
+			suite.Equal(expected, v)
+
+		case <-time.After(5 * time.Second):
+			suite.FailNow("no poll")
+		}
+	}
+
+	suite.NoError(p.stop(context.Background()))
+	suite.NoError(p.stop(context.Background()))
+}
+
+func (suite *RunnerSuite) TestPollerAlreadyStarted() {
+	var (
+		p    = poller[int]{interval: time.Hour}
+		noop = func(int) {}
+	)
+
+	query := func(context.Context) int { return 0 }
+	suite.Require().NoError(p.start(context.Background(), query, noop, noop))
+	suite.ErrorIs(
+		p.start(
+			context.Background(),
+			query,
+			func(int) { suite.Fail("initial should not be called while running") },
+			noop,
+		),
+		ErrAlreadyStarted,
+	)
+
+	suite.NoError(p.stop(context.Background()))
+}
+
+func (suite *RunnerSuite) TestWatcher() {
+	type result struct {
+		value, index uint64
+		err          error
+	}
+
+	var (
+		w           = watcher[uint64]{retryInterval: time.Millisecond}
+		expectedErr = errors.New("expected")
+
+		// each query consumes the next result, after checking the index it was sent
+		results = []result{
+			{value: 1, index: 5},
+			{value: 1, index: 6},
+			{err: expectedErr},
+			{value: 1, index: 7},
+			{value: 2, index: 3},
+			{value: 3, index: 8},
+		}
+		expectedIndexes = []uint64{0, 5, 6, 6, 7, 0}
+
+		queries    = make(chan uint64, 10)
+		dispatched = make(chan uint64, 10)
+		last       uint64
+	)
+
+	query := func(ctx context.Context, index uint64) (uint64, uint64, error) {
+		if len(results) == 0 {
+			<-ctx.Done()
+			return 0, 0, ctx.Err()
+		}
+
+		queries <- index
+		r := results[0]
+		results = results[1:]
+		return r.value, r.index, r.err
+	}
+
+	suite.Require().NoError(w.start(
+		context.Background(),
+		query,
+		func(v uint64) bool { return v != last },
+		func(v uint64) {
+			// errors are dispatched as zero and are not retained
+			if v != 0 {
+				last = v
+			}
+
+			dispatched <- v
+		},
+	))
+
+	// the unchanged result after the error is dispatched so that listeners see the recovery
+	for _, expected := range []uint64{1, 0, 1, 2, 3} {
+		select {
+		case v := <-dispatched:
+			suite.Equal(expected, v)
+
+		case <-time.After(5 * time.Second):
+			suite.FailNow("no dispatch")
+		}
+	}
+
+	suite.NoError(w.stop(context.Background()))
+	close(queries)
+	var indexes []uint64
+	for index := range queries {
+		indexes = append(indexes, index)
+	}
+
+	suite.Equal(expectedIndexes, indexes)
+	suite.Empty(dispatched)
+}
+
+func (suite *RunnerSuite) TestWatcherInitialError() {
+	var (
+		w           = watcher[int]{retryInterval: time.Millisecond}
+		expectedErr = errors.New("expected")
+	)
+
+	suite.ErrorIs(
+		w.start(
+			context.Background(),
+			func(context.Context, uint64) (int, uint64, error) { return 0, 0, expectedErr },
+			func(int) bool { return true },
+			func(int) { suite.Fail("dispatch should not be called") },
+		),
+		expectedErr,
+	)
+
+	suite.NoError(w.stop(context.Background()))
+}
+
+func TestRunner(t *testing.T) {
+	suite.Run(t, new(RunnerSuite))
+}
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+)
+
+// SemaphoreConfig describes a distributed semaphore. Every participant must use
+// the same Prefix and Limit.
+type SemaphoreConfig struct {
+	// Prefix is the consul key prefix under which the semaphore's state is kept.
+	// This field is required.
+	Prefix string `json:"prefix" yaml:"prefix" mapstructure:"prefix"`
+
+	// Limit is the maximum number of concurrent holders. This field is required.
+	Limit int `json:"limit" yaml:"limit" mapstructure:"limit"`
+
+	// Value is the optional value stored in this participant's contender key.
+	// Typically, this identifies the holder to operators.
+	Value string `json:"value" yaml:"value" mapstructure:"value"`
+
+	// SessionName is the name of the consul session used to hold a slot.
+	// If unset, the consul api package's default is used.
+	SessionName string `json:"sessionName" yaml:"sessionName" mapstructure:"sessionName"`
+
+	// SessionTTL is the TTL of the consul session used to hold a slot. The session
+	// is renewed automatically. If unset, the consul api package's default is used.
+	SessionTTL time.Duration `json:"sessionTTL" yaml:"sessionTTL" mapstructure:"sessionTTL"`
+
+	// WaitTime is the maximum time each blocking query waits while trying to acquire
+	// a slot. This also bounds how long Acquire takes to notice a canceled context.
+	// If unset, the consul api package's default is used.
+	WaitTime time.Duration `json:"waitTime" yaml:"waitTime" mapstructure:"waitTime"`
+}
+
+// Semaphore limits the number of holders of a resource across a cluster. Each
+// Semaphore can hold at most one slot at a time.
+type Semaphore struct {
+	sem *api.Semaphore
+
+	lock     sync.Mutex
+	held     bool
+	released chan struct{}
+}
+
+// NewSemaphore creates a Semaphore from the given configuration.
+func NewSemaphore(client *api.Client, cfg SemaphoreConfig) (*Semaphore, error) {
+	opts := &api.SemaphoreOptions{
+		Prefix:            cfg.Prefix,
+		Limit:             cfg.Limit,
+		SessionName:       cfg.SessionName,
+		SemaphoreWaitTime: cfg.WaitTime,
+	}
+
+	if len(cfg.Value) > 0 {
+		opts.Value = []byte(cfg.Value)
+	}
+
+	if cfg.SessionTTL > 0 {
+		opts.SessionTTL = cfg.SessionTTL.String()
+	}
+
+	sem, err := client.SemaphoreOpts(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Semaphore{
+		sem: sem,
+	}, nil
+}
+
+// Held tests if this Semaphore currently holds a slot.
+func (s *Semaphore) Held() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.held
+}
+
+// Acquire blocks until a slot is acquired or the context is canceled. If the context
+// is canceled first, the context's error is returned. Cancellation is noticed between
+// consul blocking queries, so Acquire can take up to the configured WaitTime to return.
+//
+// The returned channel is closed if the slot is lost, e.g. because consul invalidated
+// the session. It is not closed by Release. Code that relies on holding the slot, such
+// as a batch job, should stop its work when this channel is closed.
+func (s *Semaphore) Acquire(ctx context.Context) (lost <-chan struct{}, err error) {
+	consulLost, err := s.sem.Acquire(ctx.Done())
+	switch {
+	case err != nil:
+		return nil, err
+
+	case consulLost == nil:
+		return nil, ctx.Err()
+	}
+
+	holderLost := make(chan struct{})
+	released := make(chan struct{})
+
+	s.lock.Lock()
+	s.held = true
+	s.released = released
+	s.lock.Unlock()
+
+	go s.monitor(consulLost, released, holderLost)
+	return holderLost, nil
+}
+
+// monitor waits for either the loss of this semaphore's slot or an explicit release.
+func (s *Semaphore) monitor(consulLost <-chan struct{}, released <-chan struct{}, holderLost chan<- struct{}) {
+	select {
+	case <-consulLost:
+		s.lock.Lock()
+		defer s.lock.Unlock()
+
+		select {
+		case <-released:
+			// Release was called concurrently
+		default:
+			s.held, s.released = false, nil
+
+			// cleans up the consul session renewal
+			s.sem.Release()
+			close(holderLost)
+		}
+
+	case <-released:
+	}
+}
+
+// Release gives up this Semaphore's slot. If no slot is held, this method
+// returns api.ErrSemaphoreNotHeld.
+func (s *Semaphore) Release() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.held {
+		return api.ErrSemaphoreNotHeld
+	}
+
+	close(s.released)
+	s.held, s.released = false, nil
+	return s.sem.Release()
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/praetor/praetortest"
+)
+
+type SemaphoreSuite struct {
+	suite.Suite
+
+	consul *praetortest.Server
+	client *api.Client
+}
+
+func (suite *SemaphoreSuite) SetupTest() {
+	suite.consul = praetortest.NewServer(suite.T())
+	suite.client = suite.consul.Client(suite.T())
+}
+
+func (suite *SemaphoreSuite) newSemaphore(cfg SemaphoreConfig) *Semaphore {
+	s, err := NewSemaphore(suite.client, cfg)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(s)
+	return s
+}
+
+func (suite *SemaphoreSuite) TestNewSemaphoreInvalid() {
+	s, err := NewSemaphore(suite.client, SemaphoreConfig{})
+	suite.Error(err)
+	suite.Nil(s)
+}
+
+func (suite *SemaphoreSuite) TestAcquireRelease() {
+	var (
+		cfg = SemaphoreConfig{
+			Prefix:     "jobs/",
+			Limit:      2,
+			Value:      "holder",
+			SessionTTL: time.Minute,
+			WaitTime:   100 * time.Millisecond,
+		}
+
+		first  = suite.newSemaphore(cfg)
+		second = suite.newSemaphore(cfg)
+		third  = suite.newSemaphore(cfg)
+	)
+
+	suite.ErrorIs(first.Release(), api.ErrSemaphoreNotHeld)
+
+	lost, err := first.Acquire(context.Background())
+	suite.Require().NoError(err)
+	suite.NotNil(lost)
+	suite.True(first.Held())
+
+	_, err = second.Acquire(context.Background())
+	suite.Require().NoError(err)
+	suite.True(second.Held())
+
+	// the limit has been reached
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = third.Acquire(ctx)
+	suite.ErrorIs(err, context.DeadlineExceeded)
+	suite.False(third.Held())
+
+	suite.NoError(first.Release())
+	suite.False(first.Held())
+	select {
+	case <-lost:
+		suite.Fail("Release should not signal a lost slot")
+	default:
+	}
+
+	_, err = third.Acquire(context.Background())
+	suite.Require().NoError(err)
+	suite.True(third.Held())
+
+	suite.NoError(second.Release())
+	suite.NoError(third.Release())
+}
+
+func (suite *SemaphoreSuite) TestLost() {
+	s := suite.newSemaphore(SemaphoreConfig{
+		Prefix: "jobs/",
+		Limit:  1,
+	})
+
+	lost, err := s.Acquire(context.Background())
+	suite.Require().NoError(err)
+
+	sessions := suite.consul.SessionIDs()
+	suite.Require().Len(sessions, 1)
+	suite.consul.InvalidateSession(sessions[0])
+
+	select {
+	case <-lost:
+	case <-time.After(5 * time.Second):
+		suite.FailNow("the lost channel was not closed")
+	}
+
+	suite.False(s.Held())
+	suite.ErrorIs(s.Release(), api.ErrSemaphoreNotHeld)
+
+	// the semaphore can be reacquired
+	_, err = s.Acquire(context.Background())
+	suite.Require().NoError(err)
+	suite.NoError(s.Release())
+}
+
+func TestSemaphore(t *testing.T) {
+	suite.Run(t, new(SemaphoreSuite))
+}
@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"go.uber.org/fx"
+)
+
+// DefaultServiceNamesRetryInterval is the time a ServiceNamesWatcher waits before
+// retrying a failed query when no RetryInterval is configured.
+const DefaultServiceNamesRetryInterval = 5 * time.Second
+
+// ServiceNamesWatch configures a ServiceNamesWatcher.
+type ServiceNamesWatch struct {
+	// RetryInterval is the time to wait before retrying a failed query.
+	// If unset, DefaultServiceNamesRetryInterval is used.
+	RetryInterval time.Duration `json:"retryInterval" yaml:"retryInterval" mapstructure:"retryInterval"`
+
+	// Options are the base query options used for each blocking query, e.g. for
+	// datacenter or filtering. WaitIndex and the context are managed by the watcher.
+	Options api.QueryOptions `json:"-" yaml:"-" mapstructure:"-"`
+}
+
+// ServiceNamesEvent describes the set of services in the consul catalog.
+type ServiceNamesEvent struct {
+	// Services maps each service name in the catalog to the tags used by its instances.
+	Services map[string][]string
+
+	// Added holds the sorted names of services that appeared since the previous event.
+	Added []string
+
+	// Removed holds the sorted names of services that disappeared since the previous event.
+	Removed []string
+
+	// Index is the consul index associated with this event.
+	Index uint64
+
+	// Err is any error that occurred while querying consul. When this is set,
+	// the other fields are not meaningful.
+	Err error
+}
+
+// ServiceNamesListener is a sink for ServiceNamesEvents.
+type ServiceNamesListener func(ServiceNamesEvent)
+
+// ServiceNamesWatcher watches the names of the services in the consul catalog
+// using blocking queries. Listeners are notified when services appear, disappear,
+// or change their tags, which lets control-plane style applications react to new
+// families of services.
+type ServiceNamesWatcher struct {
+	catalog *api.Catalog
+	watch   ServiceNamesWatch
+
+	listeners listeners[ServiceNamesListener]
+
+	lock sync.Mutex
+	last ServiceNamesEvent
+
+	watcher watcher[ServiceNamesEvent]
+}
+
+// NewServiceNamesWatcher creates a ServiceNamesWatcher. The returned watcher
+// must be started before it will dispatch events.
+func NewServiceNamesWatcher(catalog *api.Catalog, watch ServiceNamesWatch) *ServiceNamesWatcher {
+	if watch.RetryInterval <= 0 {
+		watch.RetryInterval = DefaultServiceNamesRetryInterval
+	}
+
+	return &ServiceNamesWatcher{
+		catalog: catalog,
+		watch:   watch,
+		watcher: watcher[ServiceNamesEvent]{retryInterval: watch.RetryInterval},
+	}
+}
+
+// AddListener registers a listener for subsequent events. The returned
+// function removes the listener.
+func (w *ServiceNamesWatcher) AddListener(l ServiceNamesListener) (cancel func()) {
+	return w.listeners.add(l)
+}
+
+// Last returns the most recent successful event dispatched by this watcher.
+func (w *ServiceNamesWatcher) Last() ServiceNamesEvent {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.last
+}
+
+// Start performs an initial, non-blocking query and dispatches its result, then
+// begins watching for changes in a background goroutine. In the first event this
+// watcher dispatches, every service is reported as Added. If the initial query fails,
+// that error is returned and the watcher is not started.
+func (w *ServiceNamesWatcher) Start(ctx context.Context) error {
+	return w.watcher.start(ctx, w.query, w.changed, w.dispatch)
+}
+
+// Stop halts the background goroutine started by Start, waiting until it exits
+// or the given context is canceled. This method is idempotent.
+func (w *ServiceNamesWatcher) Stop(ctx context.Context) error {
+	return w.watcher.stop(ctx)
+}
+
+// query executes a single, possibly blocking, query against consul. Added and
+// Removed are computed relative to the last event dispatched.
+func (w *ServiceNamesWatcher) query(ctx context.Context, index uint64) (e ServiceNamesEvent, lastIndex uint64, err error) {
+	q := w.watch.Options
+	q.WaitIndex = index
+
+	var meta *api.QueryMeta
+	e.Services, meta, e.Err = w.catalog.Services(q.WithContext(ctx))
+	if meta != nil {
+		e.Index = meta.LastIndex
+	}
+
+	if e.Err == nil {
+		previous := w.Last().Services
+		e.Added = missingNames(e.Services, previous)
+		e.Removed = missingNames(previous, e.Services)
+	}
+
+	return e, e.Index, e.Err
+}
+
+// changed tests if an event holds different services than the last one dispatched.
+func (w *ServiceNamesWatcher) changed(e ServiceNamesEvent) bool {
+	return !maps.EqualFunc(w.Last().Services, e.Services, slices.Equal[[]string])
+}
+
+// missingNames returns the sorted names in a that are not in b.
+func missingNames(a, b map[string][]string) (names []string) {
+	for name := range a {
+		if _, ok := b[name]; !ok {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+	return
+}
+
+// dispatch records an event and sends it to all listeners.
+func (w *ServiceNamesWatcher) dispatch(e ServiceNamesEvent) {
+	if e.Err == nil {
+		w.lock.Lock()
+		w.last = e
+		w.lock.Unlock()
+	}
+
+	w.listeners.visit(func(l ServiceNamesListener) {
+		l(e)
+	})
+}
+
+// ProvideServiceNamesWatcher emits a *ServiceNamesWatcher with the given name, bound
+// to the enclosing application's lifecycle. The watcher's initial query happens when
+// the application starts, and the watcher is stopped when the application stops.
+//
+// This provider requires an *api.Catalog, such as the one emitted by Provide.
+func ProvideServiceNamesWatcher(name string, watch ServiceNamesWatch) fx.Option {
+	return fx.Provide(
+		fx.Annotate(
+			func(catalog *api.Catalog, l fx.Lifecycle) *ServiceNamesWatcher {
+				w := NewServiceNamesWatcher(catalog, watch)
+				l.Append(fx.StartStopHook(w.Start, w.Stop))
+				return w
+			},
+			fx.ResultTags(`name:"`+name+`"`),
+		),
+	)
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/praetor/praetortest"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+type ServiceNamesWatcherSuite struct {
+	suite.Suite
+
+	consul *praetortest.Server
+	client *api.Client
+}
+
+func (suite *ServiceNamesWatcherSuite) SetupTest() {
+	suite.consul = praetortest.NewServer(suite.T())
+	suite.client = suite.consul.Client(suite.T())
+}
+
+func (suite *ServiceNamesWatcherSuite) register(id, name string, tags ...string) {
+	suite.Require().NoError(
+		suite.client.Agent().ServiceRegister(&api.AgentServiceRegistration{
+			ID:   id,
+			Name: name,
+			Tags: tags,
+		}),
+	)
+}
+
+func (suite *ServiceNamesWatcherSuite) nextEvent(events <-chan ServiceNamesEvent) ServiceNamesEvent {
+	select {
+	case e := <-events:
+		return e
+
+	case <-time.After(5 * time.Second):
+		suite.FailNow("no service names event")
+		return ServiceNamesEvent{}
+	}
+}
+
+func (suite *ServiceNamesWatcherSuite) TestDefaults() {
+	w := NewServiceNamesWatcher(suite.client.Catalog(), ServiceNamesWatch{})
+	suite.Equal(DefaultServiceNamesRetryInterval, w.watch.RetryInterval)
+}
+
+func (suite *ServiceNamesWatcherSuite) TestWatch() {
+	suite.register("web-1", "web", "edge")
+	w := NewServiceNamesWatcher(suite.client.Catalog(), ServiceNamesWatch{
+		Options: api.QueryOptions{
+			WaitTime: time.Second,
+		},
+	})
+
+	events := make(chan ServiceNamesEvent, 10)
+	w.AddListener(func(e ServiceNamesEvent) {
+		events <- e
+	})
+
+	suite.NoError(w.Stop(context.Background()))
+	suite.Require().NoError(w.Start(context.Background()))
+	defer w.Stop(context.Background())
+	suite.ErrorIs(w.Start(context.Background()), ErrAlreadyStarted)
+
+	initial := suite.nextEvent(events)
+	suite.Require().NoError(initial.Err)
+	suite.Equal(map[string][]string{"web": {"edge"}}, initial.Services)
+	suite.Equal([]string{"web"}, initial.Added)
+	suite.Empty(initial.Removed)
+	suite.Equal(initial, w.Last())
+
+	suite.register("db-1", "db")
+	added := suite.nextEvent(events)
+	suite.Require().NoError(added.Err)
+	suite.Equal([]string{"db"}, added.Added)
+	suite.Empty(added.Removed)
+
+	// another instance with the same tags doesn't change the catalog's names
+	suite.register("web-2", "web", "edge")
+	time.Sleep(50 * time.Millisecond)
+	suite.Empty(events)
+
+	suite.register("web-3", "web", "canary")
+	retagged := suite.nextEvent(events)
+	suite.Require().NoError(retagged.Err)
+	suite.Empty(retagged.Added)
+	suite.Empty(retagged.Removed)
+	suite.Equal([]string{"canary", "edge"}, retagged.Services["web"])
+
+	suite.Require().NoError(suite.client.Agent().ServiceDeregister("db-1"))
+	removed := suite.nextEvent(events)
+	suite.Require().NoError(removed.Err)
+	suite.Empty(removed.Added)
+	suite.Equal([]string{"db"}, removed.Removed)
+}
+
+func (suite *ServiceNamesWatcherSuite) TestStartError() {
+	suite.consul.Close()
+	w := NewServiceNamesWatcher(suite.client.Catalog(), ServiceNamesWatch{})
+	suite.Error(w.Start(context.Background()))
+}
+
+func (suite *ServiceNamesWatcherSuite) TestQueryError() {
+	w := NewServiceNamesWatcher(suite.client.Catalog(), ServiceNamesWatch{
+		RetryInterval: 10 * time.Millisecond,
+	})
+
+	events := make(chan ServiceNamesEvent, 10)
+	suite.Require().NoError(w.Start(context.Background()))
+	defer w.Stop(context.Background())
+
+	w.AddListener(func(e ServiceNamesEvent) {
+		events <- e
+	})
+
+	suite.consul.Close()
+	suite.Error(suite.nextEvent(events).Err)
+}
+
+func (suite *ServiceNamesWatcherSuite) TestRecovery() {
+	suite.register("web-1", "web")
+	w := NewServiceNamesWatcher(suite.client.Catalog(), ServiceNamesWatch{
+		RetryInterval: 10 * time.Millisecond,
+		Options: api.QueryOptions{
+			WaitTime: 50 * time.Millisecond,
+		},
+	})
+
+	events := make(chan ServiceNamesEvent, 100)
+	suite.Require().NoError(w.Start(context.Background()))
+	defer w.Stop(context.Background())
+
+	w.AddListener(func(e ServiceNamesEvent) {
+		events <- e
+	})
+
+	suite.consul.SetUnavailable(true)
+	suite.Error(suite.nextEvent(events).Err)
+
+	// the catalog hasn't changed, but listeners must still learn that consul recovered
+	suite.consul.SetUnavailable(false)
+	e := suite.nextEvent(events)
+	for e.Err != nil {
+		e = suite.nextEvent(events)
+	}
+
+	suite.Contains(e.Services, "web")
+	suite.Empty(e.Added)
+	suite.Empty(e.Removed)
+}
+
+func (suite *ServiceNamesWatcherSuite) TestProvideServiceNamesWatcher() {
+	suite.register("web-1", "web")
+
+	var w *ServiceNamesWatcher
+	app := fxtest.New(
+		suite.T(),
+		fx.Supply(suite.client.Catalog()),
+		ProvideServiceNamesWatcher("names", ServiceNamesWatch{}),
+		fx.Populate(
+			fx.Annotate(&w, fx.ParamTags(`name:"names"`)),
+		),
+	)
+
+	suite.Require().NotNil(w)
+	app.RequireStart()
+	suite.Contains(w.Last().Services, "web")
+	app.RequireStop()
+}
+
+func TestServiceNamesWatcher(t *testing.T) {
+	suite.Run(t, new(ServiceNamesWatcherSuite))
+}
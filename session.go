@@ -0,0 +1,235 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"go.uber.org/fx"
+)
+
+const (
+	// DefaultSessionTTL is the session TTL used when none is configured.
+	DefaultSessionTTL = 15 * time.Second
+
+	// DefaultSessionRetryInterval is the time a SessionManager waits after a
+	// consul error before trying again.
+	DefaultSessionRetryInterval = 5 * time.Second
+)
+
+// SessionConfig describes the consul session maintained by a SessionManager.
+type SessionConfig struct {
+	// Name is the human-readable name of the session.
+	Name string `json:"name" yaml:"name" mapstructure:"name"`
+
+	// TTL is the session's TTL. The session is renewed at half this interval.
+	// If unset, DefaultSessionTTL is used.
+	TTL time.Duration `json:"ttl" yaml:"ttl" mapstructure:"ttl"`
+
+	// Behavior is what consul does with keys held by the session when it is
+	// invalidated. This must be api.SessionBehaviorRelease or api.SessionBehaviorDelete.
+	// If unset, consul's default of release is used.
+	Behavior string `json:"behavior" yaml:"behavior" mapstructure:"behavior"`
+
+	// LockDelay is the time consul prevents locks held by this session from being
+	// reacquired after the session is invalidated. If unset, consul's default is used.
+	LockDelay time.Duration `json:"lockDelay" yaml:"lockDelay" mapstructure:"lockDelay"`
+
+	// RetryInterval is the time to wait after a consul error before trying again.
+	// If unset, DefaultSessionRetryInterval is used.
+	RetryInterval time.Duration `json:"retryInterval" yaml:"retryInterval" mapstructure:"retryInterval"`
+}
+
+// SessionEvent describes a change to the session held by a SessionManager.
+type SessionEvent struct {
+	// ID is the current session ID. This will be empty if no session could be
+	// created, in which case Err holds the reason.
+	ID string
+
+	// Previous is the prior session ID, if any. The previous session is no
+	// longer valid, and any locks it held have been lost.
+	Previous string
+
+	// Err is the error that occurred while creating a new session, if any.
+	Err error
+}
+
+// SessionListener is a sink for SessionEvents.
+type SessionListener func(SessionEvent)
+
+// SessionManager maintains a single consul session. The session is renewed in
+// a background goroutine and recreated if consul invalidates it. Dependents, such as
+// locks or ephemeral keys, can use ID to obtain the current session and a listener
+// to learn when that session has changed.
+type SessionManager struct {
+	session       *api.Session
+	entry         api.SessionEntry
+	retryInterval time.Duration
+	listeners     listeners[SessionListener]
+
+	idLock sync.Mutex
+	id     string
+
+	runner runner
+}
+
+// NewSessionManager creates a SessionManager. No session is created until
+// the manager is started.
+func NewSessionManager(client *api.Client, cfg SessionConfig) *SessionManager {
+	sm := &SessionManager{
+		session: client.Session(),
+		entry: api.SessionEntry{
+			Name:      cfg.Name,
+			TTL:       cfg.TTL.String(),
+			Behavior:  cfg.Behavior,
+			LockDelay: cfg.LockDelay,
+		},
+		retryInterval: cfg.RetryInterval,
+	}
+
+	if cfg.TTL <= 0 {
+		sm.entry.TTL = DefaultSessionTTL.String()
+	}
+
+	if sm.retryInterval <= 0 {
+		sm.retryInterval = DefaultSessionRetryInterval
+	}
+
+	return sm
+}
+
+// ID returns the current session ID. This method returns the empty string if the
+// manager is not running or if no valid session currently exists.
+func (sm *SessionManager) ID() string {
+	sm.idLock.Lock()
+	defer sm.idLock.Unlock()
+	return sm.id
+}
+
+// AddListener registers a listener for session changes. The returned
+// function removes the listener.
+func (sm *SessionManager) AddListener(l SessionListener) (cancel func()) {
+	return sm.listeners.add(l)
+}
+
+// Start creates the initial session and begins renewing it in a background
+// goroutine. If the initial session cannot be created, that error is returned.
+func (sm *SessionManager) Start(context.Context) error {
+	return sm.runner.start(func() (func(context.Context), error) {
+		event := sm.create("")
+		if event.Err != nil {
+			return nil, event.Err
+		}
+
+		sm.setID(event)
+
+		return sm.run, nil
+	})
+}
+
+// Stop halts session renewal and destroys the current session, which releases
+// or deletes any keys it holds. This method is idempotent.
+func (sm *SessionManager) Stop(ctx context.Context) error {
+	if err := sm.runner.stop(ctx); err != nil {
+		return err
+	}
+
+	// the ID is only set while running, so this does nothing if never started
+	id := sm.ID()
+	sm.setID(SessionEvent{Previous: id})
+	if len(id) > 0 {
+		_, err := sm.session.Destroy(id, new(api.WriteOptions).WithContext(ctx))
+		return err
+	}
+
+	return nil
+}
+
+// create attempts to create a new session.
+func (sm *SessionManager) create(previous string) (event SessionEvent) {
+	entry := sm.entry
+	event.Previous = previous
+	event.ID, _, event.Err = sm.session.Create(&entry, nil)
+	return
+}
+
+// setID updates the current session ID, dispatching the given event.
+func (sm *SessionManager) setID(event SessionEvent) {
+	sm.idLock.Lock()
+	sm.id = event.ID
+	sm.idLock.Unlock()
+
+	if event.ID != event.Previous {
+		sm.listeners.visit(func(l SessionListener) {
+			l(event)
+		})
+	}
+}
+
+// renewInterval computes the time to wait before the next renewal.
+func (sm *SessionManager) renewInterval() time.Duration {
+	ttl, _ := time.ParseDuration(sm.entry.TTL)
+	return ttl / 2
+}
+
+// run is the background renewal loop.
+func (sm *SessionManager) run(ctx context.Context) {
+	wait := sm.renewInterval()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+
+		id := sm.ID()
+		if len(id) > 0 {
+			entry, _, err := sm.session.Renew(id, new(api.WriteOptions).WithContext(ctx))
+			switch {
+			case ctx.Err() != nil:
+				return
+
+			case err != nil:
+				// the session may still be valid, so try again soon
+				wait = sm.retryInterval
+				continue
+
+			case entry != nil:
+				wait = sm.renewInterval()
+				continue
+			}
+		}
+
+		// either there was no session, or consul has invalidated it
+		event := sm.create(id)
+		if ctx.Err() != nil {
+			return
+		}
+
+		sm.setID(event)
+		if event.Err != nil {
+			wait = sm.retryInterval
+		} else {
+			wait = sm.renewInterval()
+		}
+	}
+}
+
+// ProvideSessionManager emits a *SessionManager bound to the enclosing application's
+// lifecycle. The session is created when the application starts and destroyed
+// when the application stops.
+//
+// This provider requires an *api.Client, such as the one emitted by Provide.
+func ProvideSessionManager(cfg SessionConfig) fx.Option {
+	return fx.Provide(
+		func(client *api.Client, l fx.Lifecycle) *SessionManager {
+			sm := NewSessionManager(client, cfg)
+			l.Append(fx.StartStopHook(sm.Start, sm.Stop))
+			return sm
+		},
+	)
+}
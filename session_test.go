@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+	"github.com/xmidt-org/praetor/praetortest"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+type SessionManagerSuite struct {
+	suite.Suite
+
+	consul *praetortest.Server
+	client *api.Client
+}
+
+func (suite *SessionManagerSuite) SetupTest() {
+	suite.consul = praetortest.NewServer(suite.T())
+	suite.client = suite.consul.Client(suite.T())
+}
+
+func (suite *SessionManagerSuite) newSessionManager(cfg SessionConfig) (*SessionManager, <-chan SessionEvent) {
+	sm := NewSessionManager(suite.client, cfg)
+	suite.Require().NotNil(sm)
+
+	events := make(chan SessionEvent, 10)
+	sm.AddListener(func(event SessionEvent) {
+		events <- event
+	})
+
+	return sm, events
+}
+
+func (suite *SessionManagerSuite) nextEvent(events <-chan SessionEvent) SessionEvent {
+	select {
+	case event := <-events:
+		return event
+
+	case <-time.After(5 * time.Second):
+		suite.FailNow("no session event")
+		return SessionEvent{}
+	}
+}
+
+func (suite *SessionManagerSuite) TestDefaults() {
+	sm := NewSessionManager(suite.client, SessionConfig{})
+	suite.Equal(DefaultSessionTTL/2, sm.renewInterval())
+	suite.Equal(DefaultSessionRetryInterval, sm.retryInterval)
+}
+
+func (suite *SessionManagerSuite) TestLifecycle() {
+	sm, events := suite.newSessionManager(SessionConfig{
+		Name:     "test",
+		TTL:      20 * time.Millisecond,
+		Behavior: api.SessionBehaviorDelete,
+	})
+
+	suite.Empty(sm.ID())
+	suite.NoError(sm.Stop(context.Background()))
+	suite.Require().NoError(sm.Start(context.Background()))
+	suite.ErrorIs(sm.Start(context.Background()), ErrAlreadyStarted)
+
+	created := suite.nextEvent(events)
+	suite.NoError(created.Err)
+	suite.Empty(created.Previous)
+	suite.NotEmpty(created.ID)
+	suite.Equal(created.ID, sm.ID())
+	suite.Equal([]string{created.ID}, suite.consul.SessionIDs())
+
+	// allow several renewals, which should not produce events
+	time.Sleep(100 * time.Millisecond)
+	suite.Equal(created.ID, sm.ID())
+	suite.Empty(events)
+
+	suite.consul.InvalidateSession(created.ID)
+	recreated := suite.nextEvent(events)
+	suite.NoError(recreated.Err)
+	suite.Equal(created.ID, recreated.Previous)
+	suite.NotEmpty(recreated.ID)
+	suite.NotEqual(created.ID, recreated.ID)
+	suite.Equal(recreated.ID, sm.ID())
+
+	suite.NoError(sm.Stop(context.Background()))
+	stopped := suite.nextEvent(events)
+	suite.Equal(recreated.ID, stopped.Previous)
+	suite.Empty(stopped.ID)
+	suite.Empty(sm.ID())
+	suite.Empty(suite.consul.SessionIDs())
+}
+
+func (suite *SessionManagerSuite) TestStartError() {
+	client, err := api.NewClient(&api.Config{
+		Address: "127.0.0.1:1",
+	})
+
+	suite.Require().NoError(err)
+	sm := NewSessionManager(client, SessionConfig{})
+	suite.Error(sm.Start(context.Background()))
+	suite.Empty(sm.ID())
+}
+
+func (suite *SessionManagerSuite) TestConsulUnavailable() {
+	sm, events := suite.newSessionManager(SessionConfig{
+		TTL:           20 * time.Millisecond,
+		RetryInterval: 10 * time.Millisecond,
+	})
+
+	suite.Require().NoError(sm.Start(context.Background()))
+	created := suite.nextEvent(events)
+	suite.Require().NoError(created.Err)
+
+	// once consul is gone, renewals fail but the session is retained
+	suite.consul.Close()
+	time.Sleep(50 * time.Millisecond)
+	suite.Equal(created.ID, sm.ID())
+	suite.Error(sm.Stop(context.Background()))
+}
+
+func (suite *SessionManagerSuite) TestProvideSessionManager() {
+	var (
+		sm *SessionManager
+
+		app = fxtest.New(
+			suite.T(),
+			fx.Supply(suite.client),
+			ProvideSessionManager(SessionConfig{}),
+			fx.Populate(&sm),
+		)
+	)
+
+	suite.Require().NotNil(sm)
+	app.RequireStart()
+	suite.NotEmpty(sm.ID())
+	app.RequireStop()
+	suite.Empty(sm.ID())
+}
+
+func TestSessionManager(t *testing.T) {
+	suite.Run(t, new(SessionManagerSuite))
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"net"
+
+	"github.com/hashicorp/consul/api"
+	"go.uber.org/fx"
+)
+
+const (
+	// TaggedAddressLAN is the consul tagged address key for the LAN address.
+	TaggedAddressLAN = "lan"
+
+	// TaggedAddressWAN is the consul tagged address key for the WAN address.
+	TaggedAddressWAN = "wan"
+)
+
+// TaggedAddressesConfig describes how the LAN and WAN addresses of a service
+// are determined.
+type TaggedAddressesConfig struct {
+	// LAN selects the address used by consumers in the same datacenter.
+	LAN AddressConfig `json:"lan" yaml:"lan" mapstructure:"lan"`
+
+	// WAN selects the address used by consumers in other datacenters. If this is
+	// the zero value, the LAN address is also used as the WAN address, which
+	// matches consul's convention for nodes.
+	WAN AddressConfig `json:"wan" yaml:"wan" mapstructure:"wan"`
+}
+
+// TaggedAddresses produces the lan and wan tagged addresses for a service
+// registration. Consul also recognizes family-specific keys, such as lan_ipv4
+// and wan_ipv6, and those are populated along with lan and wan.
+type TaggedAddresses struct {
+	lan, wan *AddressProvider
+}
+
+// NewTaggedAddresses creates a TaggedAddresses from the given configuration.
+func NewTaggedAddresses(cfg TaggedAddressesConfig) (ta *TaggedAddresses, err error) {
+	ta = new(TaggedAddresses)
+	if ta.lan, err = NewAddressProvider(cfg.LAN); err != nil {
+		return nil, err
+	}
+
+	if cfg.WAN == (AddressConfig{}) {
+		ta.wan = ta.lan
+	} else if ta.wan, err = NewAddressProvider(cfg.WAN); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// ServiceAddresses detects the current addresses and returns them in the form
+// used by api.AgentServiceRegistration.TaggedAddresses. Each address uses the
+// given port.
+func (ta *TaggedAddresses) ServiceAddresses(port int) (map[string]api.ServiceAddress, error) {
+	addresses := make(map[string]api.ServiceAddress, 4)
+	for _, tagged := range []struct {
+		tag string
+		ap  *AddressProvider
+	}{
+		{tag: TaggedAddressLAN, ap: ta.lan},
+		{tag: TaggedAddressWAN, ap: ta.wan},
+	} {
+		address, err := tagged.ap.Address()
+		if err != nil {
+			return nil, err
+		}
+
+		sa := api.ServiceAddress{
+			Address: address,
+			Port:    port,
+		}
+
+		addresses[tagged.tag] = sa
+		switch ip := net.ParseIP(address); {
+		case ip == nil:
+			// a hostname has no address family
+
+		case ip.To4() != nil:
+			addresses[tagged.tag+"_ipv4"] = sa
+
+		default:
+			addresses[tagged.tag+"_ipv6"] = sa
+		}
+	}
+
+	return addresses, nil
+}
+
+// ProvideTaggedAddresses emits a *TaggedAddresses for the given configuration.
+func ProvideTaggedAddresses(cfg TaggedAddressesConfig) fx.Option {
+	return fx.Provide(
+		func() (*TaggedAddresses, error) {
+			return NewTaggedAddresses(cfg)
+		},
+	)
+}
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2025 Comcast Cable Communications Management, LLC
+// SPDX-License-Identifier: Apache-2.0
+
+package praetor
+
+import (
+	"net"
+	"testing"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+type TaggedAddressesSuite struct {
+	suite.Suite
+}
+
+func (suite *TaggedAddressesSuite) serviceAddresses(cfg TaggedAddressesConfig, port int) map[string]api.ServiceAddress {
+	ta, err := NewTaggedAddresses(cfg)
+	suite.Require().NoError(err)
+
+	addresses, err := ta.ServiceAddresses(port)
+	suite.Require().NoError(err)
+	return addresses
+}
+
+func (suite *TaggedAddressesSuite) TestLANOnly() {
+	suite.Equal(
+		map[string]api.ServiceAddress{
+			"lan":      {Address: "10.0.0.1", Port: 8080},
+			"lan_ipv4": {Address: "10.0.0.1", Port: 8080},
+			"wan":      {Address: "10.0.0.1", Port: 8080},
+			"wan_ipv4": {Address: "10.0.0.1", Port: 8080},
+		},
+		suite.serviceAddresses(
+			TaggedAddressesConfig{
+				LAN: AddressConfig{Address: "10.0.0.1"},
+			},
+			8080,
+		),
+	)
+}
+
+func (suite *TaggedAddressesSuite) TestLANAndWAN() {
+	suite.Equal(
+		map[string]api.ServiceAddress{
+			"lan":      {Address: "10.0.0.1", Port: 443},
+			"lan_ipv4": {Address: "10.0.0.1", Port: 443},
+			"wan":      {Address: "2001:db8::1", Port: 443},
+			"wan_ipv6": {Address: "2001:db8::1", Port: 443},
+		},
+		suite.serviceAddresses(
+			TaggedAddressesConfig{
+				LAN: AddressConfig{Address: "10.0.0.1"},
+				WAN: AddressConfig{Address: "2001:db8::1"},
+			},
+			443,
+		),
+	)
+}
+
+func (suite *TaggedAddressesSuite) TestHostname() {
+	suite.Equal(
+		map[string]api.ServiceAddress{
+			"lan":      {Address: "10.0.0.1", Port: 443},
+			"lan_ipv4": {Address: "10.0.0.1", Port: 443},
+			"wan":      {Address: "web.example.com", Port: 443},
+		},
+		suite.serviceAddresses(
+			TaggedAddressesConfig{
+				LAN: AddressConfig{Address: "10.0.0.1"},
+				WAN: AddressConfig{Address: "web.example.com"},
+			},
+			443,
+		),
+	)
+}
+
+func (suite *TaggedAddressesSuite) TestInvalid() {
+	ta, err := NewTaggedAddresses(TaggedAddressesConfig{LAN: AddressConfig{CIDR: "bad"}})
+	suite.Error(err)
+	suite.Nil(ta)
+
+	ta, err = NewTaggedAddresses(TaggedAddressesConfig{WAN: AddressConfig{CIDR: "bad"}})
+	suite.Error(err)
+	suite.Nil(ta)
+}
+
+func (suite *TaggedAddressesSuite) TestDetectionError() {
+	ta, err := NewTaggedAddresses(TaggedAddressesConfig{})
+	suite.Require().NoError(err)
+
+	ta.lan.interfaceAddrs = func(string) ([]net.Addr, error) {
+		return nil, nil
+	}
+
+	addresses, err := ta.ServiceAddresses(8080)
+	suite.Error(err)
+	suite.Nil(addresses)
+}
+
+func (suite *TaggedAddressesSuite) TestProvideTaggedAddresses() {
+	var ta *TaggedAddresses
+	app := fxtest.New(
+		suite.T(),
+		ProvideTaggedAddresses(TaggedAddressesConfig{
+			LAN: AddressConfig{Address: "10.0.0.1"},
+		}),
+		fx.Populate(&ta),
+	)
+
+	suite.Require().NotNil(ta)
+	app.RequireStart()
+	app.RequireStop()
+}
+
+func TestTaggedAddresses(t *testing.T) {
+	suite.Run(t, new(TaggedAddressesSuite))
+}